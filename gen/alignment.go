@@ -0,0 +1,11 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic/xscontroller
+// #include "xsalignmentframe.h"
+import "C"
+
+// XsAlignmentFrame values, taken from the SDK's header.
+const (
+	XAF_Sensor = int(C.XAF_Sensor)
+	XAF_Local  = int(C.XAF_Local)
+)
@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	mtigen "github.com/viam-labs/xsens-mti-lib/gen"
+	mtilib "github.com/viam-labs/xsens-mti-lib/serial"
+
+	"github.com/edaniels/golog"
+	"go.uber.org/multierr"
+)
+
+func runScan(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("scan", opts)
+	family := flags.String("family", "", "only list devices of this family, e.g. MTi-6x0")
+	usbOnly := flags.Bool("usb", false, "only list USB devices")
+	identify := flags.Bool("identify", true, "open the devices to read their product code and versions")
+	timeout := flags.Duration("timeout", 30*time.Second, "abort the scan after this long")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	devices, err := mtilib.Discover(ctx, mtilib.DiscoverOptions{
+		DeviceID: opts.serial,
+		Port:     opts.port,
+		Family:   *family,
+		USBOnly:  *usbOnly,
+		BaudRate: opts.baud,
+		Identify: *identify,
+	})
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		golog.Global().Warn("no devices found")
+	}
+	if opts.format == formatJSON {
+		return printValue(os.Stdout, opts.format, devices)
+	}
+	rows := [][]string{}
+	for _, d := range devices {
+		usb := ""
+		if d.USB {
+			usb = fmt.Sprintf("%d:%d", d.USBBus, d.USBAddress)
+		}
+		rows = append(rows, []string{
+			d.Port, d.DeviceID, d.Family, d.ProductCode, d.FirmwareVersion, d.HardwareVersion,
+			strconv.Itoa(d.BaudRate), usb,
+		})
+	}
+	header := []string{"port", "device_id", "family", "product_code", "firmware", "hardware", "baud_rate", "usb"}
+	return printRows(os.Stdout, opts.format, header, rows)
+}
+
+func runInfo(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("info", opts)
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	s, err := openSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	settings := mtilib.ReadDeviceSettings(s.device)
+	return printValue(os.Stdout, opts.format, map[string]interface{}{
+		"port":             s.port.Port,
+		"device_id":        settings.DeviceID,
+		"product_code":     settings.ProductCode,
+		"firmware_version": settings.FirmwareVersion,
+		"baud_rate":        settings.BaudRate,
+		"option_flags":     settings.OptionFlags,
+		"filter_profile":   settings.FilterProfile.Label,
+	})
+}
+
+func parseFields(fields string) []string {
+	if fields == "" {
+		return nil
+	}
+	return strings.Split(fields, ",")
+}
+
+func runStream(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("stream", opts)
+	fields := flags.String("fields", "sample_time,euler", "comma separated fields out of "+strings.Join(mtilib.ExportFieldNames(), ","))
+	duration := flags.Duration("duration", 0, "how long to stream for, until interrupted if 0")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	exporter, err := mtilib.NewExporter(os.Stdout, exportFormat(opts.format), parseFields(*fields))
+	if err != nil {
+		return usagef("%s", err)
+	}
+	s, err := openSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	if err := s.streamPackets(ctx, *duration, nil, exporter.WritePacket); err != nil {
+		return err
+	}
+	return exporter.Close()
+}
+
+func runConfig(ctx context.Context, opts *options, args []string) error {
+	if len(args) == 0 {
+		return usagef("expected get or set")
+	}
+	flags := newFlags("config "+args[0], opts)
+	dryRun := flags.Bool("dry-run", false, "only show the settings set would change")
+	if err := parseFlags(flags, opts, args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "get":
+		s, err := openSession(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer s.close()
+		settings := mtilib.ReadDeviceSettings(s.device)
+		if flags.NArg() == 0 {
+			return printValue(os.Stdout, opts.format, settings)
+		}
+		if err := settings.Save(flags.Arg(0)); err != nil {
+			return err
+		}
+		golog.Global().Infow("saved settings", "file", flags.Arg(0))
+		return nil
+	case "set":
+		if flags.NArg() != 1 {
+			return usagef("expected a settings file")
+		}
+		want, err := mtilib.LoadDeviceSettings(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		s, err := openSession(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer s.close()
+		var changes []mtilib.SettingChange
+		if *dryRun {
+			changes = mtilib.DiffDeviceSettings(mtilib.ReadDeviceSettings(s.device), want)
+		} else {
+			changes, err = mtilib.ApplyDeviceSettings(s.device, want)
+		}
+		rows := [][]string{}
+		for _, change := range changes {
+			rows = append(rows, []string{change.Setting, fmt.Sprint(change.Old), fmt.Sprint(change.New)})
+		}
+		if printErr := printRows(os.Stdout, opts.format, []string{"setting", "old", "new"}, rows); printErr != nil && err == nil {
+			err = printErr
+		}
+		return err
+	default:
+		return usagef("unknown config command %q", args[0])
+	}
+}
+
+func runRecord(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("record", opts)
+	output := flags.String("o", "", "the MTB file to record to")
+	duration := flags.Duration("duration", 0, "how long to record for, until interrupted if 0")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	if *output == "" {
+		return usagef("expected an output file")
+	}
+	s, err := openSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	fileName := mtigen.NewXSString(*output)
+	defer mtigen.DeleteXSString(fileName)
+	result := s.device.CreateLogFile(fileName)
+	defer mtigen.DeleteResultValue(result)
+	if mtigen.ResultCode(result) != mtigen.XRV_OK {
+		text := mtigen.XsControlResultText(result)
+		defer mtigen.DeleteXSString(text)
+		return fmt.Errorf("failed to create %q: %s", *output, text.ToStdString())
+	}
+	defer s.device.CloseLogFile()
+
+	count := 0
+	recording := false
+	err = s.streamPackets(ctx, *duration, func() error {
+		if !s.device.StartRecording() {
+			return s.lastError("start recording")
+		}
+		recording = true
+		golog.Global().Infow("recording, interrupt to stop", "file", *output)
+		return nil
+	}, func(mtigen.XSDataPacket) error {
+		count++
+		return nil
+	})
+	if !recording {
+		return err
+	}
+	if !s.device.StopRecording() {
+		err = multierr.Combine(err, s.lastError("stop recording"))
+	}
+	golog.Global().Infow("stopped recording", "file", *output, "packets", count)
+	return err
+}
+
+func runReplay(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("replay", opts)
+	fields := flags.String("fields", "sample_time,euler", "comma separated fields out of "+strings.Join(mtilib.ExportFieldNames(), ","))
+	speed := flags.Float64("speed", 1, "replay speed, 1 is real time, 0 as fast as possible")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return usagef("expected an MTB file")
+	}
+	if *speed < 0 {
+		return usagef("invalid speed %v", *speed)
+	}
+	exporter, err := mtilib.NewExporter(os.Stdout, exportFormat(opts.format), parseFields(*fields))
+	if err != nil {
+		return usagef("%s", err)
+	}
+
+	var last time.Duration
+	var lastWall time.Time
+	_, err = mtilib.ReadLogFile(flags.Arg(0), func(packet mtigen.XSDataPacket) error {
+		if *speed > 0 && packet.ContainsSampleTimeFine() {
+			t := time.Duration(packet.SampleTimeFine()) * 100 * time.Microsecond
+			if !lastWall.IsZero() && t > last {
+				wait := time.Duration(float64(t-last)/(*speed)) - time.Since(lastWall)
+				select {
+				case <-ctx.Done():
+				case <-time.After(wait):
+				}
+			}
+			last, lastWall = t, time.Now()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return exporter.WritePacket(packet)
+	})
+	if errors.Is(err, context.Canceled) {
+		err = nil
+	}
+	if closeErr := exporter.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// runExport converts an .mtb file, or the live stream when no file is
+// given, to CSV, JSON Lines or the columnar format.
+func runExport(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("export", opts)
+	format := flags.String("to", mtilib.ExportCSV, "file format: csv, jsonl or columnar")
+	fields := flags.String("fields", "", "comma separated fields out of "+strings.Join(mtilib.ExportFieldNames(), ","))
+	output := flags.String("o", "", "output file, stdout if empty")
+	duration := flags.Duration("duration", 10*time.Second, "how long to export the live stream for")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if flags.NArg() > 0 {
+		count, err := mtilib.ExportLogFile(flags.Arg(0), w, *format, parseFields(*fields))
+		if err != nil {
+			return err
+		}
+		golog.Global().Infow("exported log file", "file", flags.Arg(0), "packets", count)
+		return nil
+	}
+
+	exporter, err := mtilib.NewExporter(w, *format, parseFields(*fields))
+	if err != nil {
+		return usagef("%s", err)
+	}
+	s, err := openSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	count := 0
+	if err := s.streamPackets(ctx, *duration, nil, func(packet mtigen.XSDataPacket) error {
+		count++
+		return exporter.WritePacket(packet)
+	}); err != nil {
+		return err
+	}
+	golog.Global().Infow("exported live stream", "packets", count)
+	return exporter.Close()
+}
+
+func runReset(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("reset", opts)
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return usagef("expected what to reset")
+	}
+	what := flags.Arg(0)
+	switch what {
+	case "heading", "inclination", "filter", "reboot", "factory":
+	default:
+		return usagef("unknown reset %q", what)
+	}
+
+	s, err := openSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	var ok bool
+	switch what {
+	case "heading", "inclination":
+		if err := s.startMeasurement(); err != nil {
+			return err
+		}
+		method := mtigen.XRM_Heading
+		if what == "inclination" {
+			method = mtigen.XRM_Inclination
+		}
+		ok = mtigen.ResetOrientation(s.device, method)
+	case "filter":
+		if err := s.startMeasurement(); err != nil {
+			return err
+		}
+		s.device.RestartFilter()
+		ok = true
+	case "factory":
+		ok = s.device.RestoreFactoryDefaults() && s.device.Reset()
+	case "reboot":
+		ok = s.device.Reset()
+	}
+	if !ok {
+		return s.lastError("reset %s", what)
+	}
+	golog.Global().Infow("reset done", "reset", what, "device_id", s.port.DeviceID)
+	return nil
+}
+
+func runUdev(ctx context.Context, opts *options, args []string) error {
+	flags := newFlags("udev", opts)
+	prefix := flags.String("name", "xsens", "symlinks are named /dev/PREFIX-SERIAL")
+	output := flags.String("o", "", "rules file, stdout if empty")
+	if err := parseFlags(flags, opts, args); err != nil {
+		return err
+	}
+	devices, err := mtilib.Discover(ctx, mtilib.DiscoverOptions{
+		DeviceID: opts.serial,
+		Port:     opts.port,
+		USBOnly:  true,
+	})
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return errors.New("no USB devices found")
+	}
+
+	var rules strings.Builder
+	rules.WriteString("# Stable names for Xsens devices, install with\n")
+	rules.WriteString("#   sudo cp FILE /etc/udev/rules.d/99-xsens.rules\n")
+	rules.WriteString("#   sudo udevadm control --reload && sudo udevadm trigger\n")
+	for _, device := range devices {
+		rule, err := mtilib.UdevRule(device, *prefix+"-"+device.DeviceID)
+		if err != nil {
+			return err
+		}
+		rules.WriteString(rule + "\n")
+	}
+	if *output == "" {
+		_, err := fmt.Print(rules.String())
+		return err
+	}
+	//nolint:gosec
+	return os.WriteFile(*output, []byte(rules.String()), 0o644)
+}
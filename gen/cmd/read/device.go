@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	mtigen "github.com/viam-labs/xsens-mti-lib/gen"
+	mtilib "github.com/viam-labs/xsens-mti-lib/serial"
+
+	"github.com/edaniels/golog"
+)
+
+// session is an open device. It starts out in config mode.
+type session struct {
+	control   mtigen.XsControl
+	device    mtigen.XSDevice
+	port      mtilib.DiscoveredDevice
+	callback  mtigen.CallbackHandler
+	measuring bool
+}
+
+// openSession opens the device selected by the port, serial and baud flags.
+// A device that is not found by scanning can still be opened when both its
+// port and serial number are given.
+func openSession(ctx context.Context, opts *options) (*session, error) {
+	found, err := mtilib.Discover(ctx, mtilib.DiscoverOptions{
+		DeviceID: opts.serial,
+		Port:     opts.port,
+		BaudRate: opts.baud,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var port *mtilib.DiscoveredDevice
+	if len(found) > 0 {
+		port = &found[0]
+	} else {
+		if opts.port == "" || opts.serial == "" {
+			return nil, errors.New("no matching device found")
+		}
+		port = &mtilib.DiscoveredDevice{Port: opts.port, DeviceID: opts.serial, BaudRate: 115200}
+	}
+	if opts.baud != 0 {
+		port.BaudRate = opts.baud
+	}
+	golog.Global().Debugw("opening device", "id", port.DeviceID, "port", port.Port, "baud_rate", port.BaudRate)
+
+	control := mtigen.XsControlConstruct()
+	portName := mtigen.NewXSString(port.Port)
+	defer mtigen.DeleteXSString(portName)
+	if !control.OpenPort(portName, mtigen.NumericToBaudRate(port.BaudRate)) {
+		control.Destruct()
+		return nil, fmt.Errorf("failed to open port %q", port.Port)
+	}
+
+	devID := mtigen.NewXSDeviceId()
+	defer mtigen.DeleteXSDeviceId(devID)
+	devIDStr := mtigen.NewXSString(port.DeviceID)
+	defer mtigen.DeleteXSString(devIDStr)
+	devID.FromString(devIDStr)
+	device := control.Device(devID)
+	if device.Swigcptr() == 0 {
+		control.Destruct()
+		return nil, fmt.Errorf("no device %s on port %q", port.DeviceID, port.Port)
+	}
+	return &session{control: control, device: device, port: *port}, nil
+}
+
+// startMeasurement puts the device in measurement mode and buffers its live
+// packets.
+func (s *session) startMeasurement() error {
+	if s.callback == nil {
+		s.callback = mtigen.NewCallbackHandler()
+		mtigen.AddCallbackHandler(s.callback, s.device)
+	}
+	if !s.device.GotoMeasurement() {
+		return errors.New("failed to go to measurement mode")
+	}
+	s.measuring = true
+	return nil
+}
+
+// lastError returns the error of op failing, with the device's description
+// of its last result.
+func (s *session) lastError(format string, args ...interface{}) error {
+	text := s.device.LastResultText()
+	defer mtigen.DeleteXSString(text)
+	return fmt.Errorf("failed to %s: %s", fmt.Sprintf(format, args...), text.ToStdString())
+}
+
+// close returns the device to config mode and closes its port.
+func (s *session) close() {
+	if s.measuring && !s.device.GotoConfig() {
+		golog.Global().Warn("failed to return device to config mode")
+	}
+	s.control.Destruct()
+	if s.callback != nil {
+		mtigen.DeleteCallbackHandler(s.callback)
+	}
+}
+
+// streamPackets puts the device in measurement mode, calls started, if it
+// is not nil, and passes live packets to fn until ctx is done or, if it is
+// not zero, duration has passed.
+func (s *session) streamPackets(ctx context.Context, duration time.Duration, started func() error, fn func(packet mtigen.XSDataPacket) error) error {
+	if err := s.startMeasurement(); err != nil {
+		return err
+	}
+	if started != nil {
+		if err := started(); err != nil {
+			return err
+		}
+	}
+	if duration > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, duration)
+		defer cancel()
+	}
+	for ctx.Err() == nil {
+		if !s.callback.PacketAvailable() {
+			time.Sleep(time.Millisecond)
+			continue
+		}
+		packet := s.callback.GetNextPacket()
+		err := fn(packet)
+		mtigen.DeleteXSDataPacket(packet)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
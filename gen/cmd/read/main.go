@@ -1,73 +1,246 @@
+// Command read inspects, configures, streams and records Xsens MTi devices.
+//
+//	read COMMAND [flags] [args]
+//
+// Run it without arguments for the list of commands.
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
 
 	mtigen "github.com/viam-labs/xsens-mti-lib/gen"
+	mtilib "github.com/viam-labs/xsens-mti-lib/serial"
 
 	"github.com/edaniels/golog"
 )
 
-func main() {
-	control := mtigen.XsControlConstruct()
-	defer control.Destruct()
+// Exit codes.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
 
-	portInfoArray := mtigen.XSScannerScanPorts()
-	portInfoArrayPtr := mtigen.SwigcptrXsArrayXsPortInfo(portInfoArray.Swigcptr())
+// Output formats.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
 
-	if portInfoArrayPtr.Empty() {
-		golog.Global().Fatal("no devices found")
-		return
-	}
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(ctx context.Context, opts *options, args []string) error
+}
 
-	mtPort := portInfoArrayPtr.First()
+var commands = []command{
+	{"scan", "", "list the devices found on serial and USB ports", runScan},
+	{"info", "", "show the identity of a device", runInfo},
+	{"stream", "[-fields a,b] [-duration D]", "print the live data", runStream},
+	{"config", "get [FILE] | set [-dry-run] FILE", "show, save or apply the device settings", runConfig},
+	{"record", "-o FILE.mtb [-duration D]", "record the live data to an MTB file", runRecord},
+	{"replay", "[-speed S] [-fields a,b] FILE.mtb", "print the data of an MTB file", runReplay},
+	{"export", "[-fields a,b] [-o FILE] [-duration D] [FILE.mtb]", "convert an MTB file or live data to a file", runExport},
+	{"reset", "heading|inclination|filter|reboot|factory", "reset the orientation, filter or device", runReset},
+	{"udev", "[-name PREFIX] [-o FILE]", "write udev rules giving each USB device a stable name", runUdev},
+}
 
-	golog.Global().Info("found device",
-		"id", mtPort.DeviceId().ToString().ToStdString(),
-		"port", mtPort.PortName().ToStdString(),
-		"baudrate", mtPort.Baudrate(),
-	)
-	if mtPort.Baudrate() != mtigen.XBR_115k2 {
-		golog.Global().Fatalf("unknown baudrate %d", mtPort.Baudrate())
+// options are the flags shared by all commands.
+type options struct {
+	port   string
+	serial string
+	baud   int
+	format string
+}
+
+// usageError is returned for invalid command lines.
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+	return usageError{fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return exitUsage
+	}
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		if args[0] != "help" && args[0] != "-h" && args[0] != "--help" {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n", args[0])
+		}
+		printUsage()
+		return exitUsage
 	}
 
-	if !control.OpenPort(mtPort.PortName(), mtPort.Baudrate()) {
-		golog.Global().Fatal("failed to open port")
+	// an interrupt cancels ctx so commands can return the device to config
+	// mode before exiting
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := cmd.run(ctx, &options{format: formatTable}, args[1:])
+	var usageErr usageError
+	switch {
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(os.Stderr, "%s\nusage: read %s [flags] %s\n", err, cmd.name, cmd.args)
+		return exitUsage
+	case errors.Is(err, flag.ErrHelp):
+		return exitUsage
+	case err != nil:
+		golog.Global().Error(err)
+		return exitFailure
 	}
+	return exitOK
+}
 
-	device := control.Device(mtPort.DeviceId())
-	if device.Swigcptr() == 0 {
-		golog.Global().Fatal("expected device")
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "usage: read COMMAND [flags] [args]")
+	fmt.Fprintln(os.Stderr)
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s %s\t%s\n", cmd.name, cmd.args, cmd.summary)
 	}
+	w.Flush()
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "flags of all commands:")
+	newFlags("read", &options{}).PrintDefaults()
+}
 
-	callback := mtigen.NewCallbackHandler()
-	defer mtigen.DeleteCallbackHandler(callback)
+// newFlags returns a flag set with the flags shared by all commands.
+func newFlags(name string, opts *options) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.StringVar(&opts.port, "port", "", "serial port of the device, the first one found if empty")
+	flags.StringVar(&opts.serial, "serial", "", "serial number of the device, any if empty")
+	flags.IntVar(&opts.baud, "baud", 0, "baud rate, the one found by scanning if 0")
+	flags.StringVar(&opts.format, "format", formatTable, "output format: table, json or csv")
+	return flags
+}
 
-	mtigen.AddCallbackHandler(callback, device)
+// parseFlags parses args and checks the shared flags.
+func parseFlags(flags *flag.FlagSet, opts *options, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	switch opts.format {
+	case formatTable, formatJSON, formatCSV:
+	default:
+		return usagef("unknown format %q", opts.format)
+	}
+	if opts.baud != 0 && mtigen.NumericToBaudRate(opts.baud) == mtigen.XBR_Invalid {
+		return usagef("unsupported baud rate %d", opts.baud)
+	}
+	return nil
+}
 
-	if !device.GotoMeasurement() {
-		golog.Global().Fatal("failed to go to measurement mode")
+// exportFormat maps an output format to the matching export format.
+func exportFormat(format string) string {
+	switch format {
+	case formatJSON:
+		return mtilib.ExportJSONL
+	case formatCSV:
+		return mtilib.ExportCSV
+	default:
+		return mtilib.ExportTable
 	}
+}
 
-	for {
-		if callback.PacketAvailable() {
-			packet := callback.GetNextPacket()
-			if packet.ContainsOrientation() {
-				quaternion := packet.OrientationQuaternion()
-				fmt.Printf("\rq0:%f, q1:%f, q2:%f, q3:%f",
-					quaternion.W(),
-					quaternion.X(),
-					quaternion.Y(),
-					quaternion.Z(),
-				)
-
-				euler := packet.OrientationEuler()
-				fmt.Printf(" |Roll:%f, Pitch:%f, Yaw:%f",
-					euler.Roll(),
-					euler.Pitch(),
-					euler.Yaw(),
-				)
+// printRows prints rows under header as an aligned table, a JSON list of
+// objects or CSV.
+func printRows(w io.Writer, format string, header []string, rows [][]string) error {
+	switch format {
+	case formatJSON:
+		objects := make([]map[string]string, 0, len(rows))
+		for _, row := range rows {
+			object := map[string]string{}
+			for i, column := range header {
+				object[column] = row[i]
+			}
+			objects = append(objects, object)
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(objects)
+	case formatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(header); err != nil {
+			return err
+		}
+		if err := csvWriter.WriteAll(rows); err != nil {
+			return err
+		}
+		return csvWriter.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+		for _, row := range rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// printValue prints v as indented JSON or, for the table and CSV formats,
+// as one key/value row per top level field.
+func printValue(w io.Writer, format string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if format == formatJSON {
+		_, err := fmt.Fprintln(w, string(data))
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		var s string
+		if json.Unmarshal(fields[key], &s) != nil {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, fields[key]); err != nil {
+				return err
 			}
+			s = compact.String()
 		}
+		rows = append(rows, []string{key, s})
 	}
+	return printRows(w, format, []string{"setting", "value"}, rows)
 }
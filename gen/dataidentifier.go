@@ -0,0 +1,11 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic/xstypes
+// #include "xsdataidentifier.h"
+import "C"
+
+// XsDataIdentifier values, taken from the SDK's header.
+const (
+	XDI_PacketCounter  = int(C.XDI_PacketCounter)
+	XDI_SampleTimeFine = int(C.XDI_SampleTimeFine)
+)
@@ -650,6 +650,32 @@ extern swig_intgo _wrap_XDOF_EnablePositionVelocitySmoother_gen_be9d2f14c67e6fa7
 extern swig_intgo _wrap_XDOF_EnableContinuousZRU_gen_be9d2f14c67e6fa7(void);
 extern swig_intgo _wrap_XDOF_None_gen_be9d2f14c67e6fa7(void);
 extern swig_intgo _wrap_XDOF_All_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_SelfTestOk_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_OrientationValid_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_GpsValid_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_NoRotationMask_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_NoRotationAborted_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_NoRotationSamplesRejected_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_NoRotationRunningNormally_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_RepresentativeMotion_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ExternalClockSynced_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipAccX_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipAccY_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipAccZ_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipGyrX_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipGyrY_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipGyrZ_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipMagX_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipMagY_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClipMagZ_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_Retransmitted_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_ClippingDetected_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_Interpolated_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_SyncIn_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_SyncOut_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_FilterMode_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_HaveGnssTimePulse_gen_be9d2f14c67e6fa7(void);
+extern swig_intgo _wrap_XSF_RtkStatus_gen_be9d2f14c67e6fa7(void);
 extern uintptr_t _wrap_new_XsArrayXsPortInfo__SWIG_0_gen_be9d2f14c67e6fa7(swig_type_25 arg1, uintptr_t arg2);
 extern uintptr_t _wrap_new_XsArrayXsPortInfo__SWIG_1_gen_be9d2f14c67e6fa7(swig_type_26 arg1);
 extern uintptr_t _wrap_new_XsArrayXsPortInfo__SWIG_2_gen_be9d2f14c67e6fa7(void);
@@ -2572,6 +2598,61 @@ extern swig_type_559 _wrap_GetCallbackHandler_M_onTransmissionRequest_gen_be9d2f
 extern void _wrap_SetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(uintptr_t _swig_base, swig_type_560 arg1);
 extern swig_type_561 _wrap_GetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(uintptr_t _swig_base);
 extern void _wrap_addCallbackHandler_gen_be9d2f14c67e6fa7(uintptr_t arg1, uintptr_t arg2);
+extern swig_intgo _wrap_vectorSize_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern double _wrap_vectorAt_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern void _wrap_deleteVector_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_resultCode_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_deleteResultValue_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern uintptr_t _wrap_versionString_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_deleteVersion_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_baudRateToNumeric_gen_be9d2f14c67e6fa7(swig_intgo arg1);
+extern swig_intgo _wrap_numericToBaudRate_gen_be9d2f14c67e6fa7(swig_intgo arg1);
+extern swig_intgo _wrap_filterProfileType_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_filterProfileVersion_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern uintptr_t _wrap_filterProfileLabel_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_deleteFilterProfile_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_outputConfigurationCount_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_outputConfigurationIdentifier_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_outputConfigurationFrequency_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern void _wrap_appendOutputConfiguration_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3);
+extern uintptr_t _wrap_newOutputConfigurationArray_gen_be9d2f14c67e6fa7(void);
+extern uintptr_t _wrap_alignmentRotation_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern _Bool _wrap_setAlignmentRotation_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2, uintptr_t arg3);
+extern swig_intgo _wrap_syncSettingCount_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_syncSettingLine_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingFunction_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingPolarity_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingPulseWidth_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingOffset_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingSkipFirst_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingSkipFactor_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_syncSettingClockPeriod_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern _Bool _wrap_syncSettingTriggerOnce_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern void _wrap_appendSyncSetting_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_intgo arg4, swig_intgo arg5, swig_intgo arg6, swig_intgo arg7, swig_intgo arg8, swig_intgo arg9, _Bool arg10);
+extern uintptr_t _wrap_newSyncSettingArray_gen_be9d2f14c67e6fa7(void);
+extern void _wrap_deleteSyncSettingArray_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern _Bool _wrap_resetOrientation_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_missedPacketCount_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern uintptr_t _wrap_openLogFileDevice_gen_be9d2f14c67e6fa7(uintptr_t arg1, uintptr_t arg2);
+extern double _wrap_utcTimeSeconds_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_removeCallbackHandler_gen_be9d2f14c67e6fa7(uintptr_t arg1, uintptr_t arg2);
+extern _Bool _wrap_syncSettingsCompatible_gen_be9d2f14c67e6fa7(uintptr_t arg1, uintptr_t arg2, swig_intgo arg3, swig_intgo arg4);
+extern _Bool _wrap_containsTriggerIndication_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_triggerIndicationLine_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_triggerIndicationPolarity_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern double _wrap_triggerIndicationTimestamp_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_triggerIndicationFrameNumber_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern double _wrap_timeOfArrivalMs_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern double _wrap_estimatedTimeOfSamplingMs_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_droppedPacketCount_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_runSelfTest_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_enableScanLog_gen_be9d2f14c67e6fa7(_Bool arg1);
+extern void _wrap_setJournalLogLevel_gen_be9d2f14c67e6fa7(swig_intgo arg1);
+extern void _wrap_openJournalFile_gen_be9d2f14c67e6fa7(uintptr_t arg1, swig_intgo arg2);
+extern double _wrap_iccDdtAccuracy_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_iccDimension_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern swig_intgo _wrap_iccStatus_gen_be9d2f14c67e6fa7(uintptr_t arg1);
+extern void _wrap_deleteIccRepMotionResult_gen_be9d2f14c67e6fa7(uintptr_t arg1);
 #undef intgo
 */
 import "C"
@@ -3116,6 +3197,190 @@ func _swig_getXDOF_All() (_swig_ret XsDeviceOptionFlag) {
 }
 
 var XDOF_All XsDeviceOptionFlag = _swig_getXDOF_All()
+
+type XsStatusFlag int
+func _swig_getXSF_SelfTestOk() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_SelfTestOk_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_SelfTestOk XsStatusFlag = _swig_getXSF_SelfTestOk()
+func _swig_getXSF_OrientationValid() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_OrientationValid_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_OrientationValid XsStatusFlag = _swig_getXSF_OrientationValid()
+func _swig_getXSF_GpsValid() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_GpsValid_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_GpsValid XsStatusFlag = _swig_getXSF_GpsValid()
+func _swig_getXSF_NoRotationMask() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_NoRotationMask_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_NoRotationMask XsStatusFlag = _swig_getXSF_NoRotationMask()
+func _swig_getXSF_NoRotationAborted() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_NoRotationAborted_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_NoRotationAborted XsStatusFlag = _swig_getXSF_NoRotationAborted()
+func _swig_getXSF_NoRotationSamplesRejected() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_NoRotationSamplesRejected_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_NoRotationSamplesRejected XsStatusFlag = _swig_getXSF_NoRotationSamplesRejected()
+func _swig_getXSF_NoRotationRunningNormally() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_NoRotationRunningNormally_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_NoRotationRunningNormally XsStatusFlag = _swig_getXSF_NoRotationRunningNormally()
+func _swig_getXSF_RepresentativeMotion() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_RepresentativeMotion_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_RepresentativeMotion XsStatusFlag = _swig_getXSF_RepresentativeMotion()
+func _swig_getXSF_ExternalClockSynced() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ExternalClockSynced_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ExternalClockSynced XsStatusFlag = _swig_getXSF_ExternalClockSynced()
+func _swig_getXSF_ClipAccX() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipAccX_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipAccX XsStatusFlag = _swig_getXSF_ClipAccX()
+func _swig_getXSF_ClipAccY() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipAccY_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipAccY XsStatusFlag = _swig_getXSF_ClipAccY()
+func _swig_getXSF_ClipAccZ() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipAccZ_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipAccZ XsStatusFlag = _swig_getXSF_ClipAccZ()
+func _swig_getXSF_ClipGyrX() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipGyrX_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipGyrX XsStatusFlag = _swig_getXSF_ClipGyrX()
+func _swig_getXSF_ClipGyrY() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipGyrY_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipGyrY XsStatusFlag = _swig_getXSF_ClipGyrY()
+func _swig_getXSF_ClipGyrZ() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipGyrZ_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipGyrZ XsStatusFlag = _swig_getXSF_ClipGyrZ()
+func _swig_getXSF_ClipMagX() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipMagX_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipMagX XsStatusFlag = _swig_getXSF_ClipMagX()
+func _swig_getXSF_ClipMagY() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipMagY_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipMagY XsStatusFlag = _swig_getXSF_ClipMagY()
+func _swig_getXSF_ClipMagZ() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClipMagZ_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClipMagZ XsStatusFlag = _swig_getXSF_ClipMagZ()
+func _swig_getXSF_Retransmitted() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_Retransmitted_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_Retransmitted XsStatusFlag = _swig_getXSF_Retransmitted()
+func _swig_getXSF_ClippingDetected() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_ClippingDetected_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_ClippingDetected XsStatusFlag = _swig_getXSF_ClippingDetected()
+func _swig_getXSF_Interpolated() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_Interpolated_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_Interpolated XsStatusFlag = _swig_getXSF_Interpolated()
+func _swig_getXSF_SyncIn() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_SyncIn_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_SyncIn XsStatusFlag = _swig_getXSF_SyncIn()
+func _swig_getXSF_SyncOut() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_SyncOut_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_SyncOut XsStatusFlag = _swig_getXSF_SyncOut()
+func _swig_getXSF_FilterMode() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_FilterMode_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_FilterMode XsStatusFlag = _swig_getXSF_FilterMode()
+func _swig_getXSF_HaveGnssTimePulse() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_HaveGnssTimePulse_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_HaveGnssTimePulse XsStatusFlag = _swig_getXSF_HaveGnssTimePulse()
+func _swig_getXSF_RtkStatus() (_swig_ret XsStatusFlag) {
+	var swig_r XsStatusFlag
+	swig_r = (XsStatusFlag)(C._wrap_XSF_RtkStatus_gen_be9d2f14c67e6fa7())
+	return swig_r
+}
+
+var XSF_RtkStatus XsStatusFlag = _swig_getXSF_RtkStatus()
 type SwigcptrXsArrayXsPortInfo uintptr
 
 func (p SwigcptrXsArrayXsPortInfo) Swigcptr() uintptr {
@@ -20322,6 +20587,403 @@ func AddCallbackHandler(arg1 CallbackHandler, arg2 XSDevice) {
 	C._wrap_addCallbackHandler_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))
 }
 
+func VectorSize(arg1 XsVector) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_vectorSize_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func VectorAt(arg1 XsVector, arg2 int) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (float64)(C._wrap_vectorAt_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func DeleteVector(arg1 XsVector) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteVector_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
+func ResultCode(arg1 XsResultValue) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_resultCode_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func DeleteResultValue(arg1 XsResultValue) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteResultValue_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
+func VersionString(arg1 XsVersion) (_swig_ret XSString) {
+	var swig_r XSString
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (XSString)(SwigcptrXSString(C._wrap_versionString_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func DeleteVersion(arg1 XsVersion) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteVersion_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
+func BaudRateToNumeric(arg1 XsBaudRate) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	swig_r = (int)(C._wrap_baudRateToNumeric_gen_be9d2f14c67e6fa7(C.swig_intgo(_swig_i_0)))
+	return swig_r
+}
+
+func NumericToBaudRate(arg1 int) (_swig_ret XsBaudRate) {
+	var swig_r XsBaudRate
+	_swig_i_0 := arg1
+	swig_r = (XsBaudRate)(C._wrap_numericToBaudRate_gen_be9d2f14c67e6fa7(C.swig_intgo(_swig_i_0)))
+	return swig_r
+}
+
+func FilterProfileType(arg1 XsFilterProfile) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_filterProfileType_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func FilterProfileVersion(arg1 XsFilterProfile) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_filterProfileVersion_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func FilterProfileLabel(arg1 XsFilterProfile) (_swig_ret XSString) {
+	var swig_r XSString
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (XSString)(SwigcptrXSString(C._wrap_filterProfileLabel_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func DeleteFilterProfile(arg1 XsFilterProfile) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteFilterProfile_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
+func OutputConfigurationCount(arg1 XsOutputConfigurationArray) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_outputConfigurationCount_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func OutputConfigurationIdentifier(arg1 XsOutputConfigurationArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_outputConfigurationIdentifier_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func OutputConfigurationFrequency(arg1 XsOutputConfigurationArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_outputConfigurationFrequency_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func AppendOutputConfiguration(arg1 XsOutputConfigurationArray, arg2 int, arg3 int) {
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	C._wrap_appendOutputConfiguration_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2))
+}
+
+func NewOutputConfigurationArray() (_swig_ret XsOutputConfigurationArray) {
+	var swig_r XsOutputConfigurationArray
+	swig_r = (XsOutputConfigurationArray)(SwigcptrXsOutputConfigurationArray(C._wrap_newOutputConfigurationArray_gen_be9d2f14c67e6fa7()))
+	return swig_r
+}
+
+func AlignmentRotation(arg1 XSDevice, arg2 int) (_swig_ret XSQuaternion) {
+	var swig_r XSQuaternion
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (XSQuaternion)(SwigcptrXSQuaternion(C._wrap_alignmentRotation_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1))))
+	return swig_r
+}
+
+func SetAlignmentRotation(arg1 XSDevice, arg2 int, arg3 XSQuaternion) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	swig_r = (bool)(C._wrap_setAlignmentRotation_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.uintptr_t(_swig_i_2)))
+	return swig_r
+}
+
+func SyncSettingCount(arg1 XsSyncSettingArray) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_syncSettingCount_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func SyncSettingLine(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingLine_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingFunction(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingFunction_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingPolarity(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingPolarity_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingPulseWidth(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingPulseWidth_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingOffset(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingOffset_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingSkipFirst(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingSkipFirst_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingSkipFactor(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingSkipFactor_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingClockPeriod(arg1 XsSyncSettingArray, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_syncSettingClockPeriod_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func SyncSettingTriggerOnce(arg1 XsSyncSettingArray, arg2 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (bool)(C._wrap_syncSettingTriggerOnce_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func AppendSyncSetting(arg1 XsSyncSettingArray, arg2 int, arg3 int, arg4 int, arg5 int, arg6 int, arg7 int, arg8 int, arg9 int, arg10 bool) {
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	C._wrap_appendSyncSetting_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3), C.swig_intgo(_swig_i_4), C.swig_intgo(_swig_i_5), C.swig_intgo(_swig_i_6), C.swig_intgo(_swig_i_7), C.swig_intgo(_swig_i_8), C._Bool(_swig_i_9))
+}
+
+func NewSyncSettingArray() (_swig_ret XsSyncSettingArray) {
+	var swig_r XsSyncSettingArray
+	swig_r = (XsSyncSettingArray)(SwigcptrXsSyncSettingArray(C._wrap_newSyncSettingArray_gen_be9d2f14c67e6fa7()))
+	return swig_r
+}
+
+func DeleteSyncSettingArray(arg1 XsSyncSettingArray) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteSyncSettingArray_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
+func ResetOrientation(arg1 XSDevice, arg2 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (bool)(C._wrap_resetOrientation_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func MissedPacketCount(arg1 CallbackHandler) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_missedPacketCount_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func OpenLogFileDevice(arg1 XsControl, arg2 XSString) (_swig_ret XSDevice) {
+	var swig_r XSDevice
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	swig_r = (XSDevice)(SwigcptrXSDevice(C._wrap_openLogFileDevice_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))))
+	return swig_r
+}
+
+func UtcTimeSeconds(arg1 XSDataPacket) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (float64)(C._wrap_utcTimeSeconds_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func RemoveCallbackHandler(arg1 CallbackHandler, arg2 XSDevice) {
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	C._wrap_removeCallbackHandler_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))
+}
+
+func SyncSettingsCompatible(arg1 XSDevice, arg2 XsSyncSettingArray, arg3 int, arg4 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (bool)(C._wrap_syncSettingsCompatible_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3)))
+	return swig_r
+}
+
+func ContainsTriggerIndication(arg1 XSDataPacket, arg2 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (bool)(C._wrap_containsTriggerIndication_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func TriggerIndicationLine(arg1 XSDataPacket, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_triggerIndicationLine_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func TriggerIndicationPolarity(arg1 XSDataPacket, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_triggerIndicationPolarity_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func TriggerIndicationTimestamp(arg1 XSDataPacket, arg2 int) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (float64)(C._wrap_triggerIndicationTimestamp_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func TriggerIndicationFrameNumber(arg1 XSDataPacket, arg2 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_triggerIndicationFrameNumber_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1)))
+	return swig_r
+}
+
+func TimeOfArrivalMs(arg1 XSDataPacket) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (float64)(C._wrap_timeOfArrivalMs_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func EstimatedTimeOfSamplingMs(arg1 XSDataPacket) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (float64)(C._wrap_estimatedTimeOfSamplingMs_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func DroppedPacketCount(arg1 CallbackHandler) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_droppedPacketCount_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func RunSelfTest(arg1 XSDevice) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_runSelfTest_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func EnableScanLog(arg1 bool) {
+	_swig_i_0 := arg1
+	C._wrap_enableScanLog_gen_be9d2f14c67e6fa7(C._Bool(_swig_i_0))
+}
+
+func SetJournalLogLevel(arg1 int) {
+	_swig_i_0 := arg1
+	C._wrap_setJournalLogLevel_gen_be9d2f14c67e6fa7(C.swig_intgo(_swig_i_0))
+}
+
+func OpenJournalFile(arg1 XSString, arg2 int) {
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	C._wrap_openJournalFile_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1))
+}
+
+func IccDdtAccuracy(arg1 XsIccRepMotionResult) (_swig_ret float64) {
+	var swig_r float64
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (float64)(C._wrap_iccDdtAccuracy_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func IccDimension(arg1 XsIccRepMotionResult) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_iccDimension_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func IccStatus(arg1 XsIccRepMotionResult) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (int)(C._wrap_iccStatus_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func DeleteIccRepMotionResult(arg1 XsIccRepMotionResult) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_deleteIccRepMotionResult_gen_be9d2f14c67e6fa7(C.uintptr_t(_swig_i_0))
+}
+
 
 type SwigcptrXsFilterProfile uintptr
 type XsFilterProfile interface {
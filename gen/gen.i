@@ -44,6 +44,12 @@
 %include "third_party/xspublic/xstypes/xstypedefs.h"
 %include "third_party/xspublic/xstypes/xsarray.h"
 %include "third_party/xspublic/xstypes/xsdeviceoptionflag.h"
+%ignore XsStatus;
+%ignore XsStatusFlagOffset;
+%ignore anyAccClipped;
+%ignore anyGyrClipped;
+%ignore anyMagClipped;
+%include "third_party/xspublic/xstypes/xsstatusflag.h"
 
 %template(XsArrayXsPortInfo) XsArrayImpl<XsPortInfo, g_xsPortInfoArrayDescriptor, XsPortInfoArray>;
 %include "third_party/xspublic/xstypes/xsportinfo.h"
@@ -75,6 +81,8 @@
 
 %{
 #include <chrono>
+#include <cmath>
+#include <ctime>
 #include <thread>
 #include <iostream>
 #include <iomanip>
@@ -111,24 +119,50 @@ public:
 		return oldestPacket;
 	}
 
+	int missedPackets() const
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		return m_missedPackets;
+	}
+
+	int droppedPackets() const
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		return m_droppedPackets;
+	}
+
 protected:
 	void onLiveDataAvailable(XsDevice*, const XsDataPacket* packet) override
 	{
 		std::lock_guard<std::mutex> guard(m_mutex);
 		assert(packet != 0);
+		// the oldest packets are evicted when the buffer is full, which
+		// getNextPacket cannot do as m_mutex is already held
 		while (m_numberOfPacketsInBuffer >= m_maxNumberOfPacketsInBuffer)
-			(void)getNextPacket();
+		{
+			m_packetBuffer.pop_front();
+			--m_numberOfPacketsInBuffer;
+			++m_droppedPackets;
+		}
 
 		m_packetBuffer.push_back(*packet);
 		++m_numberOfPacketsInBuffer;
 		assert(m_numberOfPacketsInBuffer <= m_maxNumberOfPacketsInBuffer);
 	}
+
+	void onMissedPackets(XsDevice*, int count, int, int) override
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		m_missedPackets += count;
+	}
 private:
 	mutable std::mutex m_mutex;
 
 	size_t m_maxNumberOfPacketsInBuffer;
 	size_t m_numberOfPacketsInBuffer;
 	std::list<XsDataPacket> m_packetBuffer;
+	int m_missedPackets = 0;
+	int m_droppedPackets = 0;
 };
 
 using namespace std;
@@ -137,6 +171,301 @@ void addCallbackHandler(CallbackHandler* cb, XsDevice* dev) {
 	dev->addCallbackHandler(cb);
 }
 
+int vectorSize(XsVector const* v) {
+	return (int)v->size();
+}
+
+double vectorAt(XsVector const* v, int index) {
+	return v->value((XsSize)index);
+}
+
+void deleteVector(XsVector* v) {
+	delete v;
+}
+
+int resultCode(XsResultValue const* r) {
+	return (int)*r;
+}
+
+void deleteResultValue(XsResultValue* r) {
+	delete r;
+}
+
+XsString versionString(XsVersion const* v) {
+	return v->toString();
+}
+
+void deleteVersion(XsVersion* v) {
+	delete v;
+}
+
+int baudRateToNumeric(XsBaudRate rate) {
+	return XsBaud::rateToNumeric(rate);
+}
+
+XsBaudRate numericToBaudRate(int numeric) {
+	return XsBaud::numericToRate(numeric);
+}
+
+int filterProfileType(XsFilterProfile const* p) {
+	return (int)p->type();
+}
+
+int filterProfileVersion(XsFilterProfile const* p) {
+	return (int)p->version();
+}
+
+XsString filterProfileLabel(XsFilterProfile const* p) {
+	return XsString(p->label());
+}
+
+void deleteFilterProfile(XsFilterProfile* p) {
+	delete p;
+}
+
+int outputConfigurationCount(XsOutputConfigurationArray const* a) {
+	return (int)a->size();
+}
+
+int outputConfigurationIdentifier(XsOutputConfigurationArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_dataIdentifier;
+}
+
+int outputConfigurationFrequency(XsOutputConfigurationArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_frequency;
+}
+
+void appendOutputConfiguration(XsOutputConfigurationArray* a, int identifier, int frequency) {
+	a->push_back(XsOutputConfiguration((XsDataIdentifier)identifier, (uint16_t)frequency));
+}
+
+XsOutputConfigurationArray* newOutputConfigurationArray() {
+	return new XsOutputConfigurationArray();
+}
+
+XsQuaternion alignmentRotation(XsDevice const* dev, int frame) {
+	return dev->alignmentRotationQuaternion((XsAlignmentFrame)frame);
+}
+
+bool setAlignmentRotation(XsDevice* dev, int frame, XsQuaternion const& q) {
+	return dev->setAlignmentRotationQuaternion((XsAlignmentFrame)frame, q);
+}
+
+int syncSettingCount(XsSyncSettingArray const* a) {
+	return (int)a->size();
+}
+
+int syncSettingLine(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_line;
+}
+
+int syncSettingFunction(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_function;
+}
+
+int syncSettingPolarity(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_polarity;
+}
+
+int syncSettingPulseWidth(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_pulseWidth;
+}
+
+int syncSettingOffset(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_offset;
+}
+
+int syncSettingSkipFirst(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_skipFirst;
+}
+
+int syncSettingSkipFactor(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_skipFactor;
+}
+
+int syncSettingClockPeriod(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_clockPeriod;
+}
+
+bool syncSettingTriggerOnce(XsSyncSettingArray const* a, int index) {
+	return a->at((XsSize)index).m_triggerOnce != 0;
+}
+
+void appendSyncSetting(XsSyncSettingArray* a, int line, int function, int polarity, int pulseWidth, int offset, int skipFirst, int skipFactor, int clockPeriod, bool triggerOnce) {
+	a->push_back(XsSyncSetting((XsSyncLine)line, (XsSyncFunction)function, (XsSyncPolarity)polarity,
+		(uint32_t)pulseWidth, (int32_t)offset, (uint16_t)skipFirst, (uint16_t)skipFactor, (uint16_t)clockPeriod, (uint8_t)(triggerOnce ? 1 : 0)));
+}
+
+XsSyncSettingArray* newSyncSettingArray() {
+	return new XsSyncSettingArray();
+}
+
+void deleteSyncSettingArray(XsSyncSettingArray* a) {
+	delete a;
+}
+
+bool resetOrientation(XsDevice* dev, int method) {
+	return dev->resetOrientation((XsResetMethod)method);
+}
+
+int missedPacketCount(CallbackHandler const* cb) {
+	return cb->missedPackets();
+}
+
+XsDevice* openLogFileDevice(XsControl* control, XsString const& filename) {
+	if (!control->openLogFile(filename))
+		return nullptr;
+	for (auto const& id : control->mainDeviceIds()) {
+		if (!id.isMti() && !id.isMtig())
+			continue;
+		XsDevice* dev = control->device(id);
+		if (dev)
+			dev->setOptions(XSO_RetainBufferedData, XSO_None);
+		return dev;
+	}
+	return nullptr;
+}
+
+double utcTimeSeconds(XsDataPacket const* p) {
+	if (!p->containsUtcTime())
+		return NAN;
+	XsTimeInfo t = p->utcTime();
+	if ((t.m_valid & 3) != 3)
+		return NAN;
+	std::tm tm = {};
+	tm.tm_year = t.m_year - 1900;
+	tm.tm_mon = t.m_month - 1;
+	tm.tm_mday = t.m_day;
+	tm.tm_hour = t.m_hour;
+	tm.tm_min = t.m_minute;
+	tm.tm_sec = t.m_second;
+	return (double)timegm(&tm) + t.m_utcOffset * 60.0 + t.m_nano * 1e-9;
+}
+
+void removeCallbackHandler(CallbackHandler* cb, XsDevice* dev) {
+	dev->removeCallbackHandler(cb);
+}
+
+bool syncSettingsCompatible(XsDevice const* dev, XsSyncSettingArray const* a, int i, int j) {
+	return XsDevice::isCompatibleSyncSetting(dev->deviceId(), a->at((XsSize)i), a->at((XsSize)j));
+}
+
+static XsDataIdentifier triggerInput(int input) {
+	switch (input) {
+	case 1: return XDI_TriggerIn1;
+	case 2: return XDI_TriggerIn2;
+	case 3: return XDI_TriggerIn3;
+	default: return XDI_None;
+	}
+}
+
+bool containsTriggerIndication(XsDataPacket const* p, int input) {
+	XsDataIdentifier id = triggerInput(input);
+	return id != XDI_None && p->containsTriggerIndication(id);
+}
+
+int triggerIndicationLine(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_line;
+}
+
+int triggerIndicationPolarity(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_polarity;
+}
+
+double triggerIndicationTimestamp(XsDataPacket* p, int input) {
+	return (double)p->triggerIndication(triggerInput(input)).m_timestamp;
+}
+
+int triggerIndicationFrameNumber(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_frameNumber;
+}
+
+double timeOfArrivalMs(XsDataPacket const* p) {
+	return (double)p->timeOfArrival().msTime();
+}
+
+double estimatedTimeOfSamplingMs(XsDataPacket const* p) {
+	return (double)p->estimatedTimeOfSampling().msTime();
+}
+
+int droppedPacketCount(CallbackHandler const* cb) {
+	return cb->droppedPackets();
+}
+
+int runSelfTest(XsDevice* dev) {
+	return dev->runSelfTest().m_flags;
+}
+
+extern "C" void xsensScanLog(char* line);
+
+static void scanLogBridge(XsString const* line) {
+	xsensScanLog(const_cast<char*>(line->c_str()));
+}
+
+void enableScanLog(bool enable) {
+	XsScanner::setScanLogCallback(enable ? scanLogBridge : nullptr);
+}
+
+#include <xscommon/journaller.h>
+
+extern "C" void xsensJournalLog(int level, char* function, char* msg);
+
+// GoJournalLogger passes the entries of the SDK's journal to Go.
+class GoJournalLogger : public AbstractAdditionalLogger {
+public:
+	GoJournalLogger() : m_level(static_cast<JournalLogLevel>(JLL_DISABLE)) {}
+	bool logLevel(JournalLogLevel level) const override { return level >= m_level; }
+	JournalLogLevel logLevel() const override { return m_level; }
+	JournalLogLevel debugLevel() const override { return m_level; }
+	void setLogLevel(JournalLogLevel level) override { m_level = level; }
+	void setDebugLevel(JournalLogLevel level) override {}
+	void log(JournalLogLevel level, char const* file, int line, char const* function, std::string const& msg) override {
+		xsensJournalLog(level, const_cast<char*>(function), const_cast<char*>(msg.c_str()));
+	}
+	void logNoDecoration(JournalLogLevel level, char const* file, int line, char const* function, std::string const& msg) override {
+		log(level, file, line, function, msg);
+	}
+
+private:
+	volatile JournalLogLevel m_level;
+};
+
+static GoJournalLogger goJournalLogger;
+
+void setJournalLogLevel(int level) {
+	goJournalLogger.setLogLevel(static_cast<JournalLogLevel>(level));
+	Journaller::setAdditionalLogger(level < JLL_DISABLE ? &goJournalLogger : nullptr);
+}
+
+// the journal file is moved rather than closed, as the SDK's threads may be
+// writing to it
+void openJournalFile(XsString const& path, int level) {
+	if (gJournal) {
+		gJournal->moveLogFile(path, false);
+		gJournal->setLogLevel(static_cast<JournalLogLevel>(level));
+		return;
+	}
+	Journaller* journal = new Journaller(path, false, static_cast<JournalLogLevel>(level));
+	journal->writeFileHeader("xsens-mti-lib");
+	gJournal = journal;
+}
+
+double iccDdtAccuracy(XsIccRepMotionResult const* r) {
+	return (double)r->ddtAccuracy();
+}
+
+int iccDimension(XsIccRepMotionResult const* r) {
+	return (int)r->dimension();
+}
+
+int iccStatus(XsIccRepMotionResult const* r) {
+	return (int)r->status();
+}
+
+void deleteIccRepMotionResult(XsIccRepMotionResult* r) {
+	delete r;
+}
+
 %}
 
 class CallbackHandler : public XsCallback
@@ -150,3 +479,58 @@ protected:
 	void onLiveDataAvailable(XsDevice*, const XsDataPacket* packet) override;
 };
 void addCallbackHandler(CallbackHandler* cb, XsDevice* dev);
+int vectorSize(XsVector const* v);
+double vectorAt(XsVector const* v, int index);
+void deleteVector(XsVector* v);
+int resultCode(XsResultValue const* r);
+void deleteResultValue(XsResultValue* r);
+XsString versionString(XsVersion const* v);
+void deleteVersion(XsVersion* v);
+int baudRateToNumeric(XsBaudRate rate);
+XsBaudRate numericToBaudRate(int numeric);
+int filterProfileType(XsFilterProfile const* p);
+int filterProfileVersion(XsFilterProfile const* p);
+XsString filterProfileLabel(XsFilterProfile const* p);
+void deleteFilterProfile(XsFilterProfile* p);
+int outputConfigurationCount(XsOutputConfigurationArray const* a);
+int outputConfigurationIdentifier(XsOutputConfigurationArray const* a, int index);
+int outputConfigurationFrequency(XsOutputConfigurationArray const* a, int index);
+void appendOutputConfiguration(XsOutputConfigurationArray* a, int identifier, int frequency);
+XsOutputConfigurationArray* newOutputConfigurationArray();
+XsQuaternion alignmentRotation(XsDevice const* dev, int frame);
+bool setAlignmentRotation(XsDevice* dev, int frame, XsQuaternion const& q);
+int syncSettingCount(XsSyncSettingArray const* a);
+int syncSettingLine(XsSyncSettingArray const* a, int index);
+int syncSettingFunction(XsSyncSettingArray const* a, int index);
+int syncSettingPolarity(XsSyncSettingArray const* a, int index);
+int syncSettingPulseWidth(XsSyncSettingArray const* a, int index);
+int syncSettingOffset(XsSyncSettingArray const* a, int index);
+int syncSettingSkipFirst(XsSyncSettingArray const* a, int index);
+int syncSettingSkipFactor(XsSyncSettingArray const* a, int index);
+int syncSettingClockPeriod(XsSyncSettingArray const* a, int index);
+bool syncSettingTriggerOnce(XsSyncSettingArray const* a, int index);
+void appendSyncSetting(XsSyncSettingArray* a, int line, int function, int polarity, int pulseWidth, int offset, int skipFirst, int skipFactor, int clockPeriod, bool triggerOnce);
+XsSyncSettingArray* newSyncSettingArray();
+void deleteSyncSettingArray(XsSyncSettingArray* a);
+bool resetOrientation(XsDevice* dev, int method);
+int missedPacketCount(CallbackHandler const* cb);
+XsDevice* openLogFileDevice(XsControl* control, XsString const& filename);
+double utcTimeSeconds(XsDataPacket const* p);
+void removeCallbackHandler(CallbackHandler* cb, XsDevice* dev);
+bool syncSettingsCompatible(XsDevice const* dev, XsSyncSettingArray const* a, int i, int j);
+bool containsTriggerIndication(XsDataPacket const* p, int input);
+int triggerIndicationLine(XsDataPacket* p, int input);
+int triggerIndicationPolarity(XsDataPacket* p, int input);
+double triggerIndicationTimestamp(XsDataPacket* p, int input);
+int triggerIndicationFrameNumber(XsDataPacket* p, int input);
+double timeOfArrivalMs(XsDataPacket const* p);
+double estimatedTimeOfSamplingMs(XsDataPacket const* p);
+int droppedPacketCount(CallbackHandler const* cb);
+int runSelfTest(XsDevice* dev);
+void enableScanLog(bool enable);
+void setJournalLogLevel(int level);
+void openJournalFile(XsString const& path, int level);
+double iccDdtAccuracy(XsIccRepMotionResult const* r);
+int iccDimension(XsIccRepMotionResult const* r);
+int iccStatus(XsIccRepMotionResult const* r);
+void deleteIccRepMotionResult(XsIccRepMotionResult* r);
@@ -262,6 +262,8 @@ static void* Swig_malloc(int c) {
 
 
 #include <chrono>
+#include <cmath>
+#include <ctime>
 #include <thread>
 #include <iostream>
 #include <iomanip>
@@ -298,24 +300,50 @@ public:
 		return oldestPacket;
 	}
 
+	int missedPackets() const
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		return m_missedPackets;
+	}
+
+	int droppedPackets() const
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		return m_droppedPackets;
+	}
+
 protected:
 	void onLiveDataAvailable(XsDevice*, const XsDataPacket* packet) override
 	{
 		std::lock_guard<std::mutex> guard(m_mutex);
 		assert(packet != 0);
+		// the oldest packets are evicted when the buffer is full, which
+		// getNextPacket cannot do as m_mutex is already held
 		while (m_numberOfPacketsInBuffer >= m_maxNumberOfPacketsInBuffer)
-			(void)getNextPacket();
+		{
+			m_packetBuffer.pop_front();
+			--m_numberOfPacketsInBuffer;
+			++m_droppedPackets;
+		}
 
 		m_packetBuffer.push_back(*packet);
 		++m_numberOfPacketsInBuffer;
 		assert(m_numberOfPacketsInBuffer <= m_maxNumberOfPacketsInBuffer);
 	}
+
+	void onMissedPackets(XsDevice*, int count, int, int) override
+	{
+		std::lock_guard<std::mutex> guard(m_mutex);
+		m_missedPackets += count;
+	}
 private:
 	mutable std::mutex m_mutex;
 
 	size_t m_maxNumberOfPacketsInBuffer;
 	size_t m_numberOfPacketsInBuffer;
 	std::list<XsDataPacket> m_packetBuffer;
+	int m_missedPackets = 0;
+	int m_droppedPackets = 0;
 };
 
 using namespace std;
@@ -324,6 +352,301 @@ void addCallbackHandler(CallbackHandler* cb, XsDevice* dev) {
 	dev->addCallbackHandler(cb);
 }
 
+int vectorSize(XsVector const* v) {
+	return (int)v->size();
+}
+
+double vectorAt(XsVector const* v, int index) {
+	return v->value((XsSize)index);
+}
+
+void deleteVector(XsVector* v) {
+	delete v;
+}
+
+int resultCode(XsResultValue const* r) {
+	return (int)*r;
+}
+
+void deleteResultValue(XsResultValue* r) {
+	delete r;
+}
+
+XsString versionString(XsVersion const* v) {
+	return v->toString();
+}
+
+void deleteVersion(XsVersion* v) {
+	delete v;
+}
+
+int baudRateToNumeric(XsBaudRate rate) {
+	return XsBaud::rateToNumeric(rate);
+}
+
+XsBaudRate numericToBaudRate(int numeric) {
+	return XsBaud::numericToRate(numeric);
+}
+
+int filterProfileType(XsFilterProfile const* p) {
+	return (int)p->type();
+}
+
+int filterProfileVersion(XsFilterProfile const* p) {
+	return (int)p->version();
+}
+
+XsString filterProfileLabel(XsFilterProfile const* p) {
+	return XsString(p->label());
+}
+
+void deleteFilterProfile(XsFilterProfile* p) {
+	delete p;
+}
+
+int outputConfigurationCount(XsOutputConfigurationArray const* a) {
+	return (int)a->size();
+}
+
+int outputConfigurationIdentifier(XsOutputConfigurationArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_dataIdentifier;
+}
+
+int outputConfigurationFrequency(XsOutputConfigurationArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_frequency;
+}
+
+void appendOutputConfiguration(XsOutputConfigurationArray* a, int identifier, int frequency) {
+	a->push_back(XsOutputConfiguration((XsDataIdentifier)identifier, (uint16_t)frequency));
+}
+
+XsOutputConfigurationArray* newOutputConfigurationArray() {
+	return new XsOutputConfigurationArray();
+}
+
+XsQuaternion alignmentRotation(XsDevice const* dev, int frame) {
+	return dev->alignmentRotationQuaternion((XsAlignmentFrame)frame);
+}
+
+bool setAlignmentRotation(XsDevice* dev, int frame, XsQuaternion const& q) {
+	return dev->setAlignmentRotationQuaternion((XsAlignmentFrame)frame, q);
+}
+
+int syncSettingCount(XsSyncSettingArray const* a) {
+	return (int)a->size();
+}
+
+int syncSettingLine(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_line;
+}
+
+int syncSettingFunction(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_function;
+}
+
+int syncSettingPolarity(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_polarity;
+}
+
+int syncSettingPulseWidth(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_pulseWidth;
+}
+
+int syncSettingOffset(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_offset;
+}
+
+int syncSettingSkipFirst(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_skipFirst;
+}
+
+int syncSettingSkipFactor(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_skipFactor;
+}
+
+int syncSettingClockPeriod(XsSyncSettingArray const* a, int index) {
+	return (int)a->at((XsSize)index).m_clockPeriod;
+}
+
+bool syncSettingTriggerOnce(XsSyncSettingArray const* a, int index) {
+	return a->at((XsSize)index).m_triggerOnce != 0;
+}
+
+void appendSyncSetting(XsSyncSettingArray* a, int line, int function, int polarity, int pulseWidth, int offset, int skipFirst, int skipFactor, int clockPeriod, bool triggerOnce) {
+	a->push_back(XsSyncSetting((XsSyncLine)line, (XsSyncFunction)function, (XsSyncPolarity)polarity,
+		(uint32_t)pulseWidth, (int32_t)offset, (uint16_t)skipFirst, (uint16_t)skipFactor, (uint16_t)clockPeriod, (uint8_t)(triggerOnce ? 1 : 0)));
+}
+
+XsSyncSettingArray* newSyncSettingArray() {
+	return new XsSyncSettingArray();
+}
+
+void deleteSyncSettingArray(XsSyncSettingArray* a) {
+	delete a;
+}
+
+bool resetOrientation(XsDevice* dev, int method) {
+	return dev->resetOrientation((XsResetMethod)method);
+}
+
+int missedPacketCount(CallbackHandler const* cb) {
+	return cb->missedPackets();
+}
+
+XsDevice* openLogFileDevice(XsControl* control, XsString const& filename) {
+	if (!control->openLogFile(filename))
+		return nullptr;
+	for (auto const& id : control->mainDeviceIds()) {
+		if (!id.isMti() && !id.isMtig())
+			continue;
+		XsDevice* dev = control->device(id);
+		if (dev)
+			dev->setOptions(XSO_RetainBufferedData, XSO_None);
+		return dev;
+	}
+	return nullptr;
+}
+
+double utcTimeSeconds(XsDataPacket const* p) {
+	if (!p->containsUtcTime())
+		return NAN;
+	XsTimeInfo t = p->utcTime();
+	if ((t.m_valid & 3) != 3)
+		return NAN;
+	std::tm tm = {};
+	tm.tm_year = t.m_year - 1900;
+	tm.tm_mon = t.m_month - 1;
+	tm.tm_mday = t.m_day;
+	tm.tm_hour = t.m_hour;
+	tm.tm_min = t.m_minute;
+	tm.tm_sec = t.m_second;
+	return (double)timegm(&tm) + t.m_utcOffset * 60.0 + t.m_nano * 1e-9;
+}
+
+void removeCallbackHandler(CallbackHandler* cb, XsDevice* dev) {
+	dev->removeCallbackHandler(cb);
+}
+
+bool syncSettingsCompatible(XsDevice const* dev, XsSyncSettingArray const* a, int i, int j) {
+	return XsDevice::isCompatibleSyncSetting(dev->deviceId(), a->at((XsSize)i), a->at((XsSize)j));
+}
+
+static XsDataIdentifier triggerInput(int input) {
+	switch (input) {
+	case 1: return XDI_TriggerIn1;
+	case 2: return XDI_TriggerIn2;
+	case 3: return XDI_TriggerIn3;
+	default: return XDI_None;
+	}
+}
+
+bool containsTriggerIndication(XsDataPacket const* p, int input) {
+	XsDataIdentifier id = triggerInput(input);
+	return id != XDI_None && p->containsTriggerIndication(id);
+}
+
+int triggerIndicationLine(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_line;
+}
+
+int triggerIndicationPolarity(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_polarity;
+}
+
+double triggerIndicationTimestamp(XsDataPacket* p, int input) {
+	return (double)p->triggerIndication(triggerInput(input)).m_timestamp;
+}
+
+int triggerIndicationFrameNumber(XsDataPacket* p, int input) {
+	return p->triggerIndication(triggerInput(input)).m_frameNumber;
+}
+
+double timeOfArrivalMs(XsDataPacket const* p) {
+	return (double)p->timeOfArrival().msTime();
+}
+
+double estimatedTimeOfSamplingMs(XsDataPacket const* p) {
+	return (double)p->estimatedTimeOfSampling().msTime();
+}
+
+int droppedPacketCount(CallbackHandler const* cb) {
+	return cb->droppedPackets();
+}
+
+int runSelfTest(XsDevice* dev) {
+	return dev->runSelfTest().m_flags;
+}
+
+extern "C" void xsensScanLog(char* line);
+
+static void scanLogBridge(XsString const* line) {
+	xsensScanLog(const_cast<char*>(line->c_str()));
+}
+
+void enableScanLog(bool enable) {
+	XsScanner::setScanLogCallback(enable ? scanLogBridge : nullptr);
+}
+
+#include <xscommon/journaller.h>
+
+extern "C" void xsensJournalLog(int level, char* function, char* msg);
+
+// GoJournalLogger passes the entries of the SDK's journal to Go.
+class GoJournalLogger : public AbstractAdditionalLogger {
+public:
+	GoJournalLogger() : m_level(static_cast<JournalLogLevel>(JLL_DISABLE)) {}
+	bool logLevel(JournalLogLevel level) const override { return level >= m_level; }
+	JournalLogLevel logLevel() const override { return m_level; }
+	JournalLogLevel debugLevel() const override { return m_level; }
+	void setLogLevel(JournalLogLevel level) override { m_level = level; }
+	void setDebugLevel(JournalLogLevel level) override {}
+	void log(JournalLogLevel level, char const* file, int line, char const* function, std::string const& msg) override {
+		xsensJournalLog(level, const_cast<char*>(function), const_cast<char*>(msg.c_str()));
+	}
+	void logNoDecoration(JournalLogLevel level, char const* file, int line, char const* function, std::string const& msg) override {
+		log(level, file, line, function, msg);
+	}
+
+private:
+	volatile JournalLogLevel m_level;
+};
+
+static GoJournalLogger goJournalLogger;
+
+void setJournalLogLevel(int level) {
+	goJournalLogger.setLogLevel(static_cast<JournalLogLevel>(level));
+	Journaller::setAdditionalLogger(level < JLL_DISABLE ? &goJournalLogger : nullptr);
+}
+
+// the journal file is moved rather than closed, as the SDK's threads may be
+// writing to it
+void openJournalFile(XsString const& path, int level) {
+	if (gJournal) {
+		gJournal->moveLogFile(path, false);
+		gJournal->setLogLevel(static_cast<JournalLogLevel>(level));
+		return;
+	}
+	Journaller* journal = new Journaller(path, false, static_cast<JournalLogLevel>(level));
+	journal->writeFileHeader("xsens-mti-lib");
+	gJournal = journal;
+}
+
+double iccDdtAccuracy(XsIccRepMotionResult const* r) {
+	return (double)r->ddtAccuracy();
+}
+
+int iccDimension(XsIccRepMotionResult const* r) {
+	return (int)r->dimension();
+}
+
+int iccStatus(XsIccRepMotionResult const* r) {
+	return (int)r->status();
+}
+
+void deleteIccRepMotionResult(XsIccRepMotionResult* r) {
+	delete r;
+}
+
 
 #ifdef __cplusplus
 extern "C" {
@@ -1114,4251 +1437,4266 @@ intgo _wrap_XDOF_All_gen_be9d2f14c67e6fa7() {
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsPortInfo *_swig_go_1) {
-  XsSize arg1 ;
-  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+intgo _wrap_XSF_SelfTestOk_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1,(XsPortInfo const *)arg2);
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  result = XSF_SelfTestOk;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  XsSize arg1 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+intgo _wrap_XSF_OrientationValid_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1);
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  result = XSF_OrientationValid;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_2_gen_be9d2f14c67e6fa7() {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+intgo _wrap_XSF_GpsValid_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  result = XSF_GpsValid;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg1 = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+intgo _wrap_XSF_NoRotationMask_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_0; 
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg1);
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  result = XSF_NoRotationMask;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_4_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsSize arg2 ;
-  XsDataFlags arg3 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+intgo _wrap_XSF_NoRotationAborted_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (XsDataFlags)_swig_go_2; 
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1,arg2,arg3);
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  result = XSF_NoRotationAborted;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_XsArrayXsPortInfo_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+intgo _wrap_XSF_NoRotationSamplesRejected_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  delete arg1;
+  result = XSF_NoRotationSamplesRejected;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+intgo _wrap_XSF_NoRotationRunningNormally_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  (arg1)->clear();
+  result = XSF_NoRotationRunningNormally;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayXsPortInfo_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_XSF_RepresentativeMotion_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator ==((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  result = XSF_RepresentativeMotion;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayXsPortInfo_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_XSF_ExternalClockSynced_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator !=((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  result = XSF_ExternalClockSynced;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
+intgo _wrap_XSF_ClipAccX_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->reserve(arg2);
+  result = XSF_ClipAccX;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-long long _wrap_XsArrayXsPortInfo_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+intgo _wrap_XSF_ClipAccY_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->reserved();
-  _swig_go_result = result; 
+  result = XSF_ClipAccY;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayDescriptor *_wrap_XsArrayXsPortInfo_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+intgo _wrap_XSF_ClipAccZ_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (XsArrayDescriptor *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->descriptor();
-  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  result = XSF_ClipAccZ;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_wrap_XsArrayXsPortInfo_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_result;
+intgo _wrap_XSF_ClipGyrX_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->begin();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator(result); 
+  result = XSF_ClipGyrX;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_wrap_XsArrayXsPortInfo_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_result;
+intgo _wrap_XSF_ClipGyrY_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->end();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator(result); 
+  result = XSF_ClipGyrY;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_wrap_XsArrayXsPortInfo_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_result;
+intgo _wrap_XSF_ClipGyrZ_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->rbegin();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator(result); 
+  result = XSF_ClipGyrZ;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_wrap_XsArrayXsPortInfo_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_result;
+intgo _wrap_XSF_ClipMagX_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->rend();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator(result); 
+  result = XSF_ClipMagX;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_result;
+intgo _wrap_XSF_ClipMagY_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (arg1)->begin();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator(result); 
+  result = XSF_ClipMagY;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_result;
+intgo _wrap_XSF_ClipMagZ_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (arg1)->end();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator(result); 
+  result = XSF_ClipMagZ;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_result;
+intgo _wrap_XSF_Retransmitted_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (arg1)->rbegin();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator(result); 
+  result = XSF_Retransmitted;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_result;
+intgo _wrap_XSF_ClippingDetected_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (arg1)->rend();
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator(result); 
+  result = XSF_ClippingDetected;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_Interpolated_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsPortInfo *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator [](arg2);
-  *(XsPortInfo **)&_swig_go_result = result; 
+  result = XSF_Interpolated;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_SyncIn_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsPortInfo *) &(arg1)->operator [](arg2);
-  *(XsPortInfo **)&_swig_go_result = result; 
+  result = XSF_SyncIn;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsPortInfo result;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_SyncOut_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->value(arg2);
-  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  result = XSF_SyncOut;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo result;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_FilterMode_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->first();
-  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  result = XSF_FilterMode;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo result;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_HaveGnssTimePulse_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->last();
-  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  result = XSF_HaveGnssTimePulse;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+intgo _wrap_XSF_RtkStatus_gen_be9d2f14c67e6fa7() {
+  XsStatusFlag result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsPortInfo *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->at(arg2);
-  *(XsPortInfo **)&_swig_go_result = result; 
+  result = XSF_RtkStatus;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsArrayXsPortInfo_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsPortInfo *_swig_go_1) {
+  XsSize arg1 ;
+  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = (size_t)_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsPortInfo *) &(arg1)->at(arg2);
-  *(XsPortInfo **)&_swig_go_result = result; 
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1,(XsPortInfo const *)arg2);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, long long _swig_go_2) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  XsSize arg3 ;
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  XsSize arg1 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+  arg1 = (size_t)_swig_go_0; 
+  
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_2_gen_be9d2f14c67e6fa7() {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
-  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
   
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
-  XsSize arg3 ;
-  XsSize arg4 ;
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg1 = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  arg4 = (size_t)_swig_go_3; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_0; 
   
-  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg1);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_new_XsArrayXsPortInfo__SWIG_4_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsSize arg2 ;
+  XsDataFlags arg3 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (XsDataFlags)_swig_go_2; 
+  
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >(arg1,arg2,arg3);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_2) {
+void _wrap_delete_XsArrayXsPortInfo_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > arg3 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator)*argp3;
-  
   
-  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
+  delete arg1;
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_2) {
+void _wrap_XsArrayXsPortInfo_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > arg3 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator)*argp3;
-  
-  
-  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
-  
-}
-
-
-void _wrap_XsArrayXsPortInfo_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *argp3 ;
-  
-  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
-  
-  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
+  (arg1)->clear();
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
+bool _wrap_XsArrayXsPortInfo_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *argp3 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
-  
-  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
+  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
+  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator ==((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+bool _wrap_XsArrayXsPortInfo_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  
-  (arg1)->push_back((XsPortInfo const &)*arg2);
+  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
+  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator !=((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsArrayXsPortInfo_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->pop_back(arg2);
+  (arg1)->reserve(arg2);
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+long long _wrap_XsArrayXsPortInfo_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  (arg1)->pop_back();
-  
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->reserved();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+XsArrayDescriptor *_wrap_XsArrayXsPortInfo_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  
-  (arg1)->push_front((XsPortInfo const &)*arg2);
   
+  result = (XsArrayDescriptor *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->descriptor();
+  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_wrap_XsArrayXsPortInfo_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  
-  (arg1)->pop_front(arg2);
   
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->begin();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_wrap_XsArrayXsPortInfo_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  (arg1)->pop_front();
-  
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->end();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-long long _wrap_XsArrayXsPortInfo_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_wrap_XsArrayXsPortInfo_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->size();
-  _swig_go_result = result; 
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->rbegin();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_wrap_XsArrayXsPortInfo_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  
-  (arg1)->erase(arg2,arg3);
   
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->rend();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  
-  (arg1)->erase(arg2);
   
+  result = (arg1)->begin();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_1) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > arg2 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > result;
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator");
-  }
-  arg2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator)*argp2;
-  
-  
-  result = (arg1)->erase(arg2);
+  result = (arg1)->end();
   *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_1) {
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > arg2 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > result;
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator");
-  }
-  arg2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator)*argp2;
+  result = (arg1)->rbegin();
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_result;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (arg1)->erase(arg2);
+  result = (arg1)->rend();
   *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, XsPortInfo *_swig_go_2) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   XsSize arg2 ;
-  XsPortInfo *arg3 = (XsPortInfo *) 0 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
-  arg3 = *(XsPortInfo **)&_swig_go_2; 
-  
-  (arg1)->assign(arg2,(XsPortInfo const *)arg3);
   
+  result = (XsPortInfo *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->operator [](arg2);
+  *(XsPortInfo **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   XsSize arg2 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->resize(arg2);
-  
+  result = (XsPortInfo *) &(arg1)->operator [](arg2);
+  *(XsPortInfo **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   XsSize arg2 ;
+  XsPortInfo result;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->setSize(arg2);
-  
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->value(arg2);
+  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
+  XsPortInfo result;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
-  
-  (arg1)->append((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
   
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->first();
+  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_XsArrayXsPortInfo_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *result = 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
+  XsPortInfo result;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
-  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_result = result; 
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->last();
+  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayXsPortInfo_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsSize arg2 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->empty();
-  _swig_go_result = result; 
+  result = (XsPortInfo *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->at(arg2);
+  *(XsPortInfo **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsPortInfoArray *_wrap_XsArrayXsPortInfo_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+XsPortInfo *_wrap_XsArrayXsPortInfo_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfoArray *result = 0 ;
-  XsPortInfoArray *_swig_go_result;
+  XsSize arg2 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsPortInfoArray *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->inherited();
-  *(XsPortInfoArray **)&_swig_go_result = result; 
+  result = (XsPortInfo *) &(arg1)->at(arg2);
+  *(XsPortInfo **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsPortInfoArray *_wrap_XsArrayXsPortInfo_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, long long _swig_go_2) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfoArray *result = 0 ;
-  XsPortInfoArray *_swig_go_result;
+  XsPortInfo *arg2 = 0 ;
+  XsSize arg3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
   
-  result = (XsPortInfoArray *) &(arg1)->inherited();
-  *(XsPortInfoArray **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayXsPortInfo_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
+  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
+  XsSize arg3 ;
+  XsSize arg4 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  arg4 = (size_t)_swig_go_3; 
   
-  (arg1)->swap(*arg2);
+  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_2) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+  XsPortInfo *arg2 = 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > arg3 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator)*argp3;
   
-  (arg1)->swap(arg2,arg3);
+  
+  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
   
 }
 
 
-XsPortInfoArray *_wrap_XsArrayXsPortInfo_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_2) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   XsPortInfo *arg2 = 0 ;
-  XsPortInfoArray *result = 0 ;
-  XsPortInfoArray *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > arg3 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsPortInfoArray *) &(arg1)->operator <<((XsPortInfo const &)*arg2);
-  *(XsPortInfoArray **)&_swig_go_result = result; 
-  return _swig_go_result;
+  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator)*argp3;
+  
+  
+  (arg1)->insert((XsPortInfo const &)*arg2,arg3);
+  
 }
 
 
-intgo _wrap_XsArrayXsPortInfo_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< 1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (int)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->find((XsPortInfo const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
+  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
+  
 }
 
 
-void _wrap_XsArrayXsPortInfo_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+void _wrap_XsArrayXsPortInfo_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImplConst< -1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  (arg1)->removeDuplicates();
+  argp3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::const_reverse_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsPortInfo const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsArrayXsPortInfo_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
-  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
+  XsPortInfo *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  (arg1)->removeDuplicatesPredicate(arg2);
+  (arg1)->push_back((XsPortInfo const &)*arg2);
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+void _wrap_XsArrayXsPortInfo_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->sort();
+  (arg1)->pop_back(arg2);
   
 }
 
 
-void _wrap_XsArrayXsPortInfo_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+void _wrap_XsArrayXsPortInfo_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
   XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   
   arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  (arg1)->reverse();
+  (arg1)->pop_back();
   
 }
 
 
-void _wrap_XsPortInfo_clear_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+void _wrap_XsArrayXsPortInfo_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfo *arg2 = 0 ;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  XsPortInfo_clear(arg1);
+  (arg1)->push_front((XsPortInfo const &)*arg2);
   
 }
 
 
-intgo _wrap_XsPortInfo_empty_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->pop_front(arg2);
   
-  result = (int)XsPortInfo_empty((XsPortInfo const *)arg1);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XsPortInfo_portNumber_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  
+  (arg1)->pop_front();
   
-  result = (int)XsPortInfo_portNumber((XsPortInfo const *)arg1);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XsPortInfo_isUsb_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+long long _wrap_XsArrayXsPortInfo_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (int)XsPortInfo_isUsb((XsPortInfo const *)arg1);
+  result = ((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->size();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XsPortInfo_isNetwork_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->erase(arg2,arg3);
   
-  result = (int)XsPortInfo_isNetwork((XsPortInfo const *)arg1);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-_gostring_ _wrap_XsPortInfo_networkServiceName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+void _wrap_XsArrayXsPortInfo_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->erase(arg2);
   
-  result = (char *)XsPortInfo_networkServiceName((XsPortInfo const *)arg1);
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XsPortInfo_usbBus_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_wrap_XsArrayXsPortInfo_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > arg2 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  result = (int)XsPortInfo_usbBus((XsPortInfo const *)arg1);
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_XsPortInfo_usbAddress_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  argp2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator");
+  }
+  arg2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator)*argp2;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (int)XsPortInfo_usbAddress((XsPortInfo const *)arg1);
-  _swig_go_result = result; 
+  result = (arg1)->erase(arg2);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsPortInfo_swap_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_wrap_XsArrayXsPortInfo_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > arg2 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  
+  argp2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator");
+  }
+  arg2 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator)*argp2;
   
-  XsPortInfo_swap(arg1,arg2);
   
+  result = (arg1)->erase(arg2);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_Invalid_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, XsPortInfo *_swig_go_2) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
+  XsPortInfo *arg3 = (XsPortInfo *) 0 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = *(XsPortInfo **)&_swig_go_2; 
   
-  result = XPLO_Invalid;
+  (arg1)->assign(arg2,(XsPortInfo const *)arg3);
   
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_RTS_Set_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = XPLO_RTS_Set;
+  (arg1)->resize(arg2);
   
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_RTS_Clear_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = XPLO_RTS_Clear;
+  (arg1)->setSize(arg2);
   
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_RTS_Ignore_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = XPLO_RTS_Ignore;
+  (arg1)->append((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
   
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_DTR_Set_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
-  
+XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_wrap_XsArrayXsPortInfo_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *result = 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_result;
   
-  result = XPLO_DTR_Set;
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  _swig_go_result = (intgo)result; 
+  result = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl const &)*arg2);
+  *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_DTR_Clear_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
-  
+bool _wrap_XsArrayXsPortInfo_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  result = XPLO_DTR_Clear;
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  _swig_go_result = (intgo)result; 
+  result = (bool)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->empty();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_DTR_Ignore_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
-  
+XsPortInfoArray *_wrap_XsArrayXsPortInfo_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfoArray *result = 0 ;
+  XsPortInfoArray *_swig_go_result;
   
-  result = XPLO_DTR_Ignore;
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  _swig_go_result = (intgo)result; 
+  result = (XsPortInfoArray *) &((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->inherited();
+  *(XsPortInfoArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_All_Set_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
-  
+XsPortInfoArray *_wrap_XsArrayXsPortInfo_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfoArray *result = 0 ;
+  XsPortInfoArray *_swig_go_result;
   
-  result = XPLO_All_Set;
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
   
-  _swig_go_result = (intgo)result; 
+  result = (XsPortInfoArray *) &(arg1)->inherited();
+  *(XsPortInfoArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_All_Clear_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl *arg2 = 0 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = XPLO_All_Clear;
+  (arg1)->swap(*arg2);
   
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_All_Ignore_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
-  intgo _swig_go_result;
+void _wrap_XsArrayXsPortInfo_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
-  result = XPLO_All_Ignore;
+  (arg1)->swap(arg2,arg3);
   
-  _swig_go_result = (intgo)result; 
+}
+
+
+XsPortInfoArray *_wrap_XsArrayXsPortInfo_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  XsPortInfoArray *result = 0 ;
+  XsPortInfoArray *_swig_go_result;
+  
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  
+  result = (XsPortInfoArray *) &(arg1)->operator <<((XsPortInfo const &)*arg2);
+  *(XsPortInfoArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XPLO_RtsCtsFlowControl_gen_be9d2f14c67e6fa7() {
-  XsPortLinesOptions result;
+intgo _wrap_XsArrayXsPortInfo_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  int result;
   intgo _swig_go_result;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = XPLO_RtsCtsFlowControl;
-  
-  _swig_go_result = (intgo)result; 
+  result = (int)((XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > const *)arg1)->find((XsPortInfo const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_new_XSPortInfo__SWIG_0_gen_be9d2f14c67e6fa7() {
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+void _wrap_XsArrayXsPortInfo_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  
+  (arg1)->removeDuplicates();
   
-  result = (XsPortInfo *)new XsPortInfo();
-  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
-  return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_new_XSPortInfo__SWIG_1_gen_be9d2f14c67e6fa7(XsString *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2) {
-  XsString *arg1 = 0 ;
-  XsBaudRate arg2 ;
-  XsPortLinesOptions arg3 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+void _wrap_XsArrayXsPortInfo_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0, void* _swig_go_1) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
+  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
   
-  arg1 = *(XsString **)&_swig_go_0; 
-  arg2 = (XsBaudRate)_swig_go_1; 
-  arg3 = (XsPortLinesOptions)_swig_go_2; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  
+  (arg1)->removeDuplicatesPredicate(arg2);
   
-  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1,arg2,arg3);
-  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
-  return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_new_XSPortInfo__SWIG_2_gen_be9d2f14c67e6fa7(XsString *_swig_go_0, intgo _swig_go_1) {
-  XsString *arg1 = 0 ;
-  XsBaudRate arg2 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+void _wrap_XsArrayXsPortInfo_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   
-  arg1 = *(XsString **)&_swig_go_0; 
-  arg2 = (XsBaudRate)_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  
+  (arg1)->sort();
   
-  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1,arg2);
-  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
-  return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_new_XSPortInfo__SWIG_3_gen_be9d2f14c67e6fa7(XsString *_swig_go_0) {
-  XsString *arg1 = 0 ;
-  XsPortInfo *result = 0 ;
-  XsPortInfo *_swig_go_result;
+void _wrap_XsArrayXsPortInfo_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *_swig_go_0) {
+  XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *arg1 = (XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > *) 0 ;
   
-  arg1 = *(XsString **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsPortInfo,g_xsPortInfoArrayDescriptor,XsPortInfoArray > **)&_swig_go_0; 
+  
+  (arg1)->reverse();
   
-  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1);
-  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_clear_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+void _wrap_XsPortInfo_clear_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  (arg1)->clear();
+  XsPortInfo_clear(arg1);
   
 }
 
 
-bool _wrap_XSPortInfo_empty_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+intgo _wrap_XsPortInfo_empty_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (bool)((XsPortInfo const *)arg1)->empty();
+  result = (int)XsPortInfo_empty((XsPortInfo const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_opGt_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+intgo _wrap_XsPortInfo_portNumber_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (bool)((XsPortInfo const *)arg1)->operator >((XsPortInfo const &)*arg2);
+  result = (int)XsPortInfo_portNumber((XsPortInfo const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_opLt_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+intgo _wrap_XsPortInfo_isUsb_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (bool)((XsPortInfo const *)arg1)->operator <((XsPortInfo const &)*arg2);
+  result = (int)XsPortInfo_isUsb((XsPortInfo const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_opEq__SWIG_0_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+intgo _wrap_XsPortInfo_isNetwork_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (bool)((XsPortInfo const *)arg1)->operator ==((XsPortInfo const &)*arg2);
+  result = (int)XsPortInfo_isNetwork((XsPortInfo const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_opEq__SWIG_1_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, _gostring_ _swig_go_1) {
+_gostring_ _wrap_XsPortInfo_networkServiceName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  
-  result = (bool)((XsPortInfo const *)arg1)->operator ==((char const *)arg2);
-  _swig_go_result = result; 
-  free(arg2); 
+  result = (char *)XsPortInfo_networkServiceName((XsPortInfo const *)arg1);
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XSPortInfo_portNumber_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+intgo _wrap_XsPortInfo_usbBus_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (int)((XsPortInfo const *)arg1)->portNumber();
+  result = (int)XsPortInfo_usbBus((XsPortInfo const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsString *_wrap_XSPortInfo_portName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+intgo _wrap_XsPortInfo_usbAddress_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsString result;
-  XsString *_swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsPortInfo const *)arg1)->portName();
-  *(XsString **)&_swig_go_result = new XsString(result); 
+  result = (int)XsPortInfo_usbAddress((XsPortInfo const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-_gostring_ _wrap_XSPortInfo_portName_c_str_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+void _wrap_XsPortInfo_swap_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+  XsPortInfo *arg2 = (XsPortInfo *) 0 ;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  
+  XsPortInfo_swap(arg1,arg2);
   
-  result = (char *)((XsPortInfo const *)arg1)->portName_c_str();
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
-  return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_setPortName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsString *_swig_go_1) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsString *arg2 = 0 ;
+intgo _wrap_XPLO_Invalid_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
   
-  (arg1)->setPortName((XsString const &)*arg2);
+  result = XPLO_Invalid;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_isUsb_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_XPLO_RTS_Set_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (bool)((XsPortInfo const *)arg1)->isUsb();
-  _swig_go_result = result; 
+  result = XPLO_RTS_Set;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSPortInfo_isNetwork_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_XPLO_RTS_Clear_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (bool)((XsPortInfo const *)arg1)->isNetwork();
-  _swig_go_result = result; 
+  result = XPLO_RTS_Clear;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsString *_wrap_XSPortInfo_networkServiceName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsString result;
-  XsString *_swig_go_result;
+intgo _wrap_XPLO_RTS_Ignore_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsPortInfo const *)arg1)->networkServiceName();
-  *(XsString **)&_swig_go_result = new XsString(result); 
+  result = XPLO_RTS_Ignore;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XSPortInfo_usbBus_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
+intgo _wrap_XPLO_DTR_Set_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
   intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (int)((XsPortInfo const *)arg1)->usbBus();
-  _swig_go_result = result; 
+  result = XPLO_DTR_Set;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XSPortInfo_usbAddress_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  int result;
+intgo _wrap_XPLO_DTR_Clear_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
   intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (int)((XsPortInfo const *)arg1)->usbAddress();
-  _swig_go_result = result; 
+  result = XPLO_DTR_Clear;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XSPortInfo_baudrate_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsBaudRate result;
+intgo _wrap_XPLO_DTR_Ignore_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
   intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (XsBaudRate)((XsPortInfo const *)arg1)->baudrate();
+  result = XPLO_DTR_Ignore;
+  
   _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_setBaudrate_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, intgo _swig_go_1) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsBaudRate arg2 ;
+intgo _wrap_XPLO_All_Set_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = (XsBaudRate)_swig_go_1; 
   
-  (arg1)->setBaudrate(arg2);
+  result = XPLO_All_Set;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-XsDeviceId *_wrap_XSPortInfo_deviceId_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsDeviceId result;
-  XsDeviceId *_swig_go_result;
+intgo _wrap_XPLO_All_Clear_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsPortInfo const *)arg1)->deviceId();
-  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
+  result = XPLO_All_Clear;
+  
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_setDeviceId_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsDeviceId *_swig_go_1) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsDeviceId arg2 ;
-  XsDeviceId *argp2 ;
-  
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  
-  argp2 = (XsDeviceId *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsDeviceId");
-  }
-  arg2 = (XsDeviceId)*argp2;
+intgo _wrap_XPLO_All_Ignore_gen_be9d2f14c67e6fa7() {
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
   
-  (arg1)->setDeviceId(arg2);
+  result = XPLO_All_Ignore;
   
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_XSPortInfo_linesOptions_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+intgo _wrap_XPLO_RtsCtsFlowControl_gen_be9d2f14c67e6fa7() {
   XsPortLinesOptions result;
   intgo _swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (XsPortLinesOptions)((XsPortInfo const *)arg1)->linesOptions();
+  result = XPLO_RtsCtsFlowControl;
+  
   _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_setLinesOptions_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, intgo _swig_go_1) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  XsPortLinesOptions arg2 ;
-  
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = (XsPortLinesOptions)_swig_go_1; 
+XsPortInfo *_wrap_new_XSPortInfo__SWIG_0_gen_be9d2f14c67e6fa7() {
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
-  (arg1)->setLinesOptions(arg2);
   
+  result = (XsPortInfo *)new XsPortInfo();
+  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_getVidPid_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, uint16_t *_swig_go_1, uint16_t *_swig_go_2) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  uint16_t *arg2 = 0 ;
-  uint16_t *arg3 = 0 ;
-  
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = *(uint16_t **)&_swig_go_1; 
-  arg3 = *(uint16_t **)&_swig_go_2; 
+XsPortInfo *_wrap_new_XSPortInfo__SWIG_1_gen_be9d2f14c67e6fa7(XsString *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2) {
+  XsString *arg1 = 0 ;
+  XsBaudRate arg2 ;
+  XsPortLinesOptions arg3 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
-  ((XsPortInfo const *)arg1)->getVidPid(*arg2,*arg3);
+  arg1 = *(XsString **)&_swig_go_0; 
+  arg2 = (XsBaudRate)_swig_go_1; 
+  arg3 = (XsPortLinesOptions)_swig_go_2; 
   
+  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1,arg2,arg3);
+  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XSPortInfo_setVidPid_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, short _swig_go_1, short _swig_go_2) {
-  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
-  uint16_t arg2 ;
-  uint16_t arg3 ;
+XsPortInfo *_wrap_new_XSPortInfo__SWIG_2_gen_be9d2f14c67e6fa7(XsString *_swig_go_0, intgo _swig_go_1) {
+  XsString *arg1 = 0 ;
+  XsBaudRate arg2 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
   
-  arg1 = *(XsPortInfo **)&_swig_go_0; 
-  arg2 = (uint16_t)_swig_go_1; 
-  arg3 = (uint16_t)_swig_go_2; 
+  arg1 = *(XsString **)&_swig_go_0; 
+  arg2 = (XsBaudRate)_swig_go_1; 
   
-  (arg1)->setVidPid(arg2,arg3);
+  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1,arg2);
+  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
+  return _swig_go_result;
+}
+
+
+XsPortInfo *_wrap_new_XSPortInfo__SWIG_3_gen_be9d2f14c67e6fa7(XsString *_swig_go_0) {
+  XsString *arg1 = 0 ;
+  XsPortInfo *result = 0 ;
+  XsPortInfo *_swig_go_result;
+  
+  arg1 = *(XsString **)&_swig_go_0; 
   
+  result = (XsPortInfo *)new XsPortInfo((XsString const &)*arg1);
+  *(XsPortInfo **)&_swig_go_result = (XsPortInfo *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_delete_XSPortInfo_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+void _wrap_XSPortInfo_clear_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
   XsPortInfo *arg1 = (XsPortInfo *) 0 ;
   
   arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  delete arg1;
+  (arg1)->clear();
   
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsDevicePtr *_swig_go_1) {
-  XsSize arg1 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+bool _wrap_XSPortInfo_empty_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1,(XsDevicePtr const *)arg2);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
+  result = (bool)((XsPortInfo const *)arg1)->empty();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  XsSize arg1 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+bool _wrap_XSPortInfo_opGt_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
+  result = (bool)((XsPortInfo const *)arg1)->operator >((XsPortInfo const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_2_gen_be9d2f14c67e6fa7() {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+bool _wrap_XSPortInfo_opLt_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
+  result = (bool)((XsPortInfo const *)arg1)->operator <((XsPortInfo const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg1 = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+bool _wrap_XSPortInfo_opEq__SWIG_0_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg1);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
+  result = (bool)((XsPortInfo const *)arg1)->operator ==((XsPortInfo const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtr *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  XsDevicePtr *arg1 = (XsDevicePtr *) 0 ;
-  XsSize arg2 ;
-  XsDataFlags arg3 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
-  
-  arg1 = *(XsDevicePtr **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (XsDataFlags)_swig_go_2; 
+bool _wrap_XSPortInfo_opEq__SWIG_1_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, _gostring_ _swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1,arg2,arg3);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
-  return _swig_go_result;
-}
-
-
-void _wrap_delete_XsArrayImplXsDevice_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
   
-  delete arg1;
   
+  result = (bool)((XsPortInfo const *)arg1)->operator ==((char const *)arg2);
+  _swig_go_result = result; 
+  free(arg2); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+intgo _wrap_XSPortInfo_portNumber_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  (arg1)->clear();
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
+  result = (int)((XsPortInfo const *)arg1)->portNumber();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayImplXsDevice_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+XsString *_wrap_XSPortInfo_portName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsString result;
+  XsString *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator ==((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  result = ((XsPortInfo const *)arg1)->portName();
+  *(XsString **)&_swig_go_result = new XsString(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayImplXsDevice_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+_gostring_ _wrap_XSPortInfo_portName_c_str_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator !=((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  result = (char *)((XsPortInfo const *)arg1)->portName_c_str();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
+void _wrap_XSPortInfo_setPortName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsString *_swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsString *arg2 = 0 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
   
-  (arg1)->reserve(arg2);
+  (arg1)->setPortName((XsString const &)*arg2);
   
 }
 
 
-long long _wrap_XsArrayImplXsDevice_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+bool _wrap_XSPortInfo_isUsb_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->reserved();
+  result = (bool)((XsPortInfo const *)arg1)->isUsb();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayDescriptor *_wrap_XsArrayImplXsDevice_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+bool _wrap_XSPortInfo_isNetwork_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (XsArrayDescriptor *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->descriptor();
-  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  result = (bool)((XsPortInfo const *)arg1)->isNetwork();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsDevice_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
+XsString *_wrap_XSPortInfo_networkServiceName_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsString result;
+  XsString *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->begin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  result = ((XsPortInfo const *)arg1)->networkServiceName();
+  *(XsString **)&_swig_go_result = new XsString(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsDevice_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
+intgo _wrap_XSPortInfo_usbBus_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->end();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  result = (int)((XsPortInfo const *)arg1)->usbBus();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsDevice_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
+intgo _wrap_XSPortInfo_usbAddress_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->rbegin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
+  result = (int)((XsPortInfo const *)arg1)->usbAddress();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsDevice_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
+intgo _wrap_XSPortInfo_baudrate_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsBaudRate result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->rend();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
+  result = (XsBaudRate)((XsPortInfo const *)arg1)->baudrate();
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
+void _wrap_XSPortInfo_setBaudrate_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, intgo _swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsBaudRate arg2 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = (XsBaudRate)_swig_go_1; 
+  
+  (arg1)->setBaudrate(arg2);
   
-  result = (arg1)->begin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
+XsDeviceId *_wrap_XSPortInfo_deviceId_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsDeviceId result;
+  XsDeviceId *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (arg1)->end();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  result = ((XsPortInfo const *)arg1)->deviceId();
+  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
+void _wrap_XSPortInfo_setDeviceId_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, XsDeviceId *_swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsDeviceId arg2 ;
+  XsDeviceId *argp2 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  
+  argp2 = (XsDeviceId *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsDeviceId");
+  }
+  arg2 = (XsDeviceId)*argp2;
+  
+  
+  (arg1)->setDeviceId(arg2);
   
-  result = (arg1)->rbegin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
+intgo _wrap_XSPortInfo_linesOptions_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsPortLinesOptions result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
   
-  result = (arg1)->rend();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
+  result = (XsPortLinesOptions)((XsPortInfo const *)arg1)->linesOptions();
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XSPortInfo_setLinesOptions_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, intgo _swig_go_1) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  XsPortLinesOptions arg2 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = (XsPortLinesOptions)_swig_go_1; 
+  
+  (arg1)->setLinesOptions(arg2);
   
-  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator [](arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XSPortInfo_getVidPid_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, uint16_t *_swig_go_1, uint16_t *_swig_go_2) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  uint16_t *arg2 = 0 ;
+  uint16_t *arg3 = 0 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = *(uint16_t **)&_swig_go_1; 
+  arg3 = *(uint16_t **)&_swig_go_2; 
+  
+  ((XsPortInfo const *)arg1)->getVidPid(*arg2,*arg3);
   
-  result = (XsDevicePtr *) &(arg1)->operator [](arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XSPortInfo_setVidPid_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0, short _swig_go_1, short _swig_go_2) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  uint16_t arg2 ;
+  uint16_t arg3 ;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  arg2 = (uint16_t)_swig_go_1; 
+  arg3 = (uint16_t)_swig_go_2; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->value(arg2);
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
+  (arg1)->setVidPid(arg2,arg3);
+  
+}
+
+
+void _wrap_delete_XSPortInfo_gen_be9d2f14c67e6fa7(XsPortInfo *_swig_go_0) {
+  XsPortInfo *arg1 = (XsPortInfo *) 0 ;
+  
+  arg1 = *(XsPortInfo **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsSize arg1 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+  
+  arg1 = (size_t)_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1,(XsDevicePtr const *)arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  XsSize arg1 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = (size_t)_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->first();
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_2_gen_be9d2f14c67e6fa7() {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->last();
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg1 = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_0; 
   
-  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->at(arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg1);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsArrayImplXsDevice_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_new_XsArrayImplXsDevice__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtr *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  XsDevicePtr *arg1 = (XsDevicePtr *) 0 ;
   XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+  XsDataFlags arg3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg1 = *(XsDevicePtr **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
+  arg3 = (XsDataFlags)_swig_go_2; 
   
-  result = (XsDevicePtr *) &(arg1)->at(arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >(arg1,arg2,arg3);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2) {
+void _wrap_delete_XsArrayImplXsDevice_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  XsSize arg3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
   
-  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
+  delete arg1;
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
+void _wrap_XsArrayImplXsDevice_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  XsSize arg3 ;
-  XsSize arg4 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  arg4 = (size_t)_swig_go_3; 
   
-  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
+  (arg1)->clear();
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2) {
+bool _wrap_XsArrayImplXsDevice_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
-  
-  
-  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator ==((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2) {
+bool _wrap_XsArrayImplXsDevice_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
-  
-  
-  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator !=((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
+void _wrap_XsArrayImplXsDevice_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
+  XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
+  arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
+  (arg1)->reserve(arg2);
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
+long long _wrap_XsArrayImplXsDevice_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
+  XsSize result;
+  long long _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
-  
-  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
-  
-}
-
-
-void _wrap_XsArrayImplXsDevice_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  (arg1)->push_back((XsDevicePtr const &)*arg2);
-  
-}
-
-
-void _wrap_XsArrayImplXsDevice_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  
-  (arg1)->pop_back(arg2);
-  
-}
-
-
-void _wrap_XsArrayImplXsDevice_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  
-  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  
-  (arg1)->pop_back();
   
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->reserved();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+XsArrayDescriptor *_wrap_XsArrayImplXsDevice_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  (arg1)->push_front((XsDevicePtr const &)*arg2);
   
+  result = (XsArrayDescriptor *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->descriptor();
+  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsDevice_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  
-  (arg1)->pop_front(arg2);
   
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->begin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsDevice_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  (arg1)->pop_front();
-  
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->end();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-long long _wrap_XsArrayImplXsDevice_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsDevice_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->size();
-  _swig_go_result = result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->rbegin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsDevice_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  
-  (arg1)->erase(arg2,arg3);
   
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->rend();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  
-  (arg1)->erase(arg2);
   
+  result = (arg1)->begin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > arg2 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator");
-  }
-  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator)*argp2;
-  
-  
-  result = (arg1)->erase(arg2);
+  result = (arg1)->end();
   *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > arg2 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator");
-  }
-  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator)*argp2;
-  
-  
-  result = (arg1)->erase(arg2);
+  result = (arg1)->rbegin();
   *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, XsDevicePtr *_swig_go_2) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *arg3 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = *(XsDevicePtr **)&_swig_go_2; 
-  
-  (arg1)->assign(arg2,(XsDevicePtr const *)arg3);
   
+  result = (arg1)->rend();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->resize(arg2);
-  
+  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->operator [](arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->setSize(arg2);
-  
+  result = (XsDevicePtr *) &(arg1)->operator [](arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsSize arg2 ;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
-  
-  (arg1)->append((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  arg2 = (size_t)_swig_go_1; 
   
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->value(arg2);
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_XsArrayImplXsDevice_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_result = result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->first();
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayImplXsDevice_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->empty();
-  _swig_go_result = result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->last();
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
   return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_XsArrayImplXsDevice_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsDevicePtrArray *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->inherited();
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
+  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->at(arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_XsArrayImplXsDevice_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+XsDevicePtr *_wrap_XsArrayImplXsDevice_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsDevicePtrArray *) &(arg1)->inherited();
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
+  result = (XsDevicePtr *) &(arg1)->at(arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsDevice_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+void _wrap_XsArrayImplXsDevice_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  XsSize arg3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
-  (arg1)->swap(*arg2);
+  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+void _wrap_XsArrayImplXsDevice_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsSize arg2 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
   XsSize arg3 ;
+  XsSize arg4 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   arg3 = (size_t)_swig_go_2; 
+  arg4 = (size_t)_swig_go_3; 
   
-  (arg1)->swap(arg2,arg3);
+  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-XsDevicePtrArray *_wrap_XsArrayImplXsDevice_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+void _wrap_XsArrayImplXsDevice_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsDevicePtr *arg2 = 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  result = (XsDevicePtrArray *) &(arg1)->operator <<((XsDevicePtr const &)*arg2);
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
-  return _swig_go_result;
-}
-
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
+  
+  
+  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
+  
+}
 
-intgo _wrap_XsArrayImplXsDevice_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+
+void _wrap_XsArrayImplXsDevice_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsDevicePtr *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  result = (int)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->find((XsDevicePtr const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
+  
+  
+  (arg1)->insert((XsDevicePtr const &)*arg2,arg3);
+  
 }
 
 
-void _wrap_XsArrayImplXsDevice_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsDevice_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  (arg1)->removeDuplicates();
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsArrayImplXsDevice_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
-  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  (arg1)->removeDuplicatesPredicate(arg2);
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsDevice_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  (arg1)->sort();
+  (arg1)->push_back((XsDevicePtr const &)*arg2);
   
 }
 
 
-void _wrap_XsArrayImplXsDevice_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsDevice_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->reverse();
+  (arg1)->pop_back(arg2);
   
 }
 
 
-XsArrayDescriptor *_wrap_g_xsDevicePtrArrayDescriptor_get_gen_be9d2f14c67e6fa7() {
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  
+  (arg1)->pop_back();
   
-  result = (XsArrayDescriptor *)&g_xsDevicePtrArrayDescriptor;
-  *(XsArrayDescriptor **)&_swig_go_result = (XsArrayDescriptor *)result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsDevicePtr *_swig_go_1) {
-  XsSize arg1 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1,(XsDevicePtr const *)arg2);
-  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
-  return _swig_go_result;
+  (arg1)->push_front((XsDevicePtr const &)*arg2);
+  
 }
 
 
-XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  XsSize arg1 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->pop_front(arg2);
   
-  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1);
-  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_2_gen_be9d2f14c67e6fa7() {
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  
+  (arg1)->pop_front();
   
-  result = (XsDevicePtrArray *)new XsDevicePtrArray();
-  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+long long _wrap_XsArrayImplXsDevice_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  result = (XsDevicePtrArray *)new XsDevicePtrArray((XsDevicePtrArray const &)*arg1);
-  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->size();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtr *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  XsDevicePtr *arg1 = (XsDevicePtr *) 0 ;
+void _wrap_XsArrayImplXsDevice_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsSize arg2 ;
-  XsDataFlags arg3 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  XsSize arg3 ;
   
-  arg1 = *(XsDevicePtr **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
-  arg3 = (XsDataFlags)_swig_go_2; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->erase(arg2,arg3);
   
-  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1,arg2,arg3);
-  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_XsDevicePtrArray_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+void _wrap_XsArrayImplXsDevice_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  delete arg1;
+  (arg1)->erase(arg2);
   
 }
 
 
-void _wrap_XsDevicePtrArray_clear_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsDevice_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > arg2 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->clear();
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-}
-
-
-bool _wrap_XsDevicePtrArray_opEq_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator");
+  }
+  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator)*argp2;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator ==((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  result = (arg1)->erase(arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsDevicePtrArray_opNEq_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsDevice_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > arg2 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator !=((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
+  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator");
+  }
+  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator)*argp2;
+  
+  
+  result = (arg1)->erase(arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_reserve_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+void _wrap_XsArrayImplXsDevice_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, XsDevicePtr *_swig_go_2) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   XsSize arg2 ;
+  XsDevicePtr *arg3 = (XsDevicePtr *) 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
+  arg3 = *(XsDevicePtr **)&_swig_go_2; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->reserve(arg2);
+  (arg1)->assign(arg2,(XsDevicePtr const *)arg3);
   
 }
 
 
-long long _wrap_XsDevicePtrArray_reserved_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+void _wrap_XsArrayImplXsDevice_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->resize(arg2);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->reserved();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsArrayDescriptor *_wrap_XsDevicePtrArray_descriptor_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->setSize(arg2);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsArrayDescriptor *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->descriptor();
-  *(XsArrayDescriptor **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsDevicePtrArray_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  
+  (arg1)->append((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->begin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_XsArrayImplXsDevice_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->begin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsDevicePtrArray_end__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
+bool _wrap_XsArrayImplXsDevice_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->end();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->empty();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_end__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
+XsDevicePtrArray *_wrap_XsArrayImplXsDevice_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->end();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  result = (XsDevicePtrArray *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->inherited();
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsDevicePtrArray_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
+XsDevicePtrArray *_wrap_XsArrayImplXsDevice_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->rbegin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
+  result = (XsDevicePtrArray *) &(arg1)->inherited();
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  
+  (arg1)->swap(*arg2);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->rbegin();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsDevicePtrArray_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->swap(arg2,arg3);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->rend();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
+XsDevicePtrArray *_wrap_XsArrayImplXsDevice_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->rend();
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
+  result = (XsDevicePtrArray *) &(arg1)->operator <<((XsDevicePtr const &)*arg2);
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+intgo _wrap_XsArrayImplXsDevice_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator [](arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
+  result = (int)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)arg1)->find((XsDevicePtr const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  
+  (arg1)->removeDuplicates();
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtr *) &(swig_b0)->operator [](arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_value_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0, void* _swig_go_1) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
+  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  
+  (arg1)->removeDuplicatesPredicate(arg2);
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->value(arg2);
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_first_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  
+  (arg1)->sort();
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->first();
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_last_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr result;
-  XsDevicePtr *_swig_go_result;
+void _wrap_XsArrayImplXsDevice_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_0) {
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *arg1 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *) 0 ;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > **)&_swig_go_0; 
+  
+  (arg1)->reverse();
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->last();
-  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
-  return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_at__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+XsArrayDescriptor *_wrap_g_xsDevicePtrArrayDescriptor_get_gen_be9d2f14c67e6fa7() {
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->at(arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
+  result = (XsArrayDescriptor *)&g_xsDevicePtrArrayDescriptor;
+  *(XsArrayDescriptor **)&_swig_go_result = (XsArrayDescriptor *)result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtr *_wrap_XsDevicePtrArray_at__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *result = 0 ;
-  XsDevicePtr *_swig_go_result;
+XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsSize arg1 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = (size_t)_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtr *) &(swig_b0)->at(arg2);
-  *(XsDevicePtr **)&_swig_go_result = result; 
+  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1,(XsDevicePtr const *)arg2);
+  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  XsSize arg3 ;
-  
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  XsSize arg1 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
+  arg1 = (size_t)_swig_go_0; 
   
+  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1);
+  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  XsSize arg3 ;
-  XsSize arg4 ;
-  
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  arg4 = (size_t)_swig_go_3; 
+XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_2_gen_be9d2f14c67e6fa7() {
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
+  result = (XsDevicePtrArray *)new XsDevicePtrArray();
+  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
+XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
-  
-  
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
   
+  result = (XsDevicePtrArray *)new XsDevicePtrArray((XsDevicePtrArray const &)*arg1);
+  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2) {
-  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
-  
-  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
-  
+XsDevicePtrArray *_wrap_new_XsDevicePtrArray__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtr *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  XsDevicePtr *arg1 = (XsDevicePtr *) 0 ;
+  XsSize arg2 ;
+  XsDataFlags arg3 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
+  arg1 = *(XsDevicePtr **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (XsDataFlags)_swig_go_2; 
   
+  result = (XsDevicePtrArray *)new XsDevicePtrArray(arg1,arg2,arg3);
+  *(XsDevicePtrArray **)&_swig_go_result = (XsDevicePtrArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
+void _wrap_delete_XsDevicePtrArray_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
   
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
+  delete arg1;
   
 }
 
 
-void _wrap_XsDevicePtrArray_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
+void _wrap_XsDevicePtrArray_clear_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
+  (swig_b0)->clear();
   
 }
 
 
-void _wrap_XsDevicePtrArray_push_back_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+bool _wrap_XsDevicePtrArray_opEq_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->push_back((XsDevicePtr const &)*arg2);
-  
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator ==((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+bool _wrap_XsDevicePtrArray_opNEq_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->pop_back(arg2);
-  
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator !=((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+void _wrap_XsDevicePtrArray_reserve_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->pop_back();
+  (swig_b0)->reserve(arg2);
   
 }
 
 
-void _wrap_XsDevicePtrArray_push_front_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+long long _wrap_XsDevicePtrArray_reserved_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtr *arg2 = 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->push_front((XsDevicePtr const &)*arg2);
-  
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->reserved();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+XsArrayDescriptor *_wrap_XsDevicePtrArray_descriptor_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->pop_front(arg2);
-  
+  result = (XsArrayDescriptor *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->descriptor();
+  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsDevicePtrArray_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->pop_front();
-  
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->begin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-long long _wrap_XsDevicePtrArray_size_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->size();
-  _swig_go_result = result; 
+  result = (swig_b0)->begin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_wrap_XsDevicePtrArray_end__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->erase(arg2,arg3);
-  
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->end();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_end__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->erase(arg2);
-  
+  result = (swig_b0)->end();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsDevicePtrArray_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > arg2 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *argp2 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator");
-  }
-  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator)*argp2;
-  
-  
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->erase(arg2);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->rbegin();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_1) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > arg2 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator");
-  }
-  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator)*argp2;
-  
-  
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (swig_b0)->erase(arg2);
+  result = (swig_b0)->rbegin();
   *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_assign_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, XsDevicePtr *_swig_go_2) {
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_wrap_XsDevicePtrArray_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
-  XsDevicePtr *arg3 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = *(XsDevicePtr **)&_swig_go_2; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->assign(arg2,(XsDevicePtr const *)arg3);
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->rend();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
+  
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (swig_b0)->rend();
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_resize_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+XsDevicePtr *_wrap_XsDevicePtrArray_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->resize(arg2);
-  
+  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->operator [](arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_setSize_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+XsDevicePtr *_wrap_XsDevicePtrArray_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->setSize(arg2);
-  
+  result = (XsDevicePtr *) &(swig_b0)->operator [](arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_append_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+XsDevicePtr *_wrap_XsDevicePtrArray_value_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsSize arg2 ;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->append((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->value(arg2);
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_XsDevicePtrArray_opAssign_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+XsDevicePtr *_wrap_XsDevicePtrArray_first_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *result = 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *) &(swig_b0)->operator =((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
-  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_result = result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->first();
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsDevicePtrArray_empty_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+XsDevicePtr *_wrap_XsDevicePtrArray_last_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevicePtr result;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->empty();
-  _swig_go_result = result; 
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->last();
+  *(XsDevicePtr **)&_swig_go_result = new XsDevicePtr(result); 
   return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_XsDevicePtrArray_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+XsDevicePtr *_wrap_XsDevicePtrArray_at__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtrArray *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->inherited();
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
+  result = (XsDevicePtr *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->at(arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDevicePtrArray *_wrap_XsDevicePtrArray_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+XsDevicePtr *_wrap_XsDevicePtrArray_at__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  XsSize arg2 ;
+  XsDevicePtr *result = 0 ;
+  XsDevicePtr *_swig_go_result;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtrArray *) &(swig_b0)->inherited();
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
+  result = (XsDevicePtr *) &(swig_b0)->at(arg2);
+  *(XsDevicePtr **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsDevicePtrArray_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+void _wrap_XsDevicePtrArray_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  XsSize arg3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->swap(*arg2);
+  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
   
 }
 
 
-void _wrap_XsDevicePtrArray_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+void _wrap_XsDevicePtrArray_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsSize arg2 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
   XsSize arg3 ;
+  XsSize arg4 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   arg3 = (size_t)_swig_go_2; 
+  arg4 = (size_t)_swig_go_3; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->swap(arg2,arg3);
+  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-XsDevicePtrArray *_wrap_XsDevicePtrArray_opLeftShift_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+void _wrap_XsDevicePtrArray_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   XsDevicePtr *arg2 = 0 ;
-  XsDevicePtrArray *result = 0 ;
-  XsDevicePtrArray *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
+  
+  
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (XsDevicePtrArray *) &(swig_b0)->operator <<((XsDevicePtr const &)*arg2);
-  *(XsDevicePtrArray **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
+  
 }
 
 
-intgo _wrap_XsDevicePtrArray_find_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+void _wrap_XsDevicePtrArray_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   XsDevicePtr *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
+  
+  
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  result = (int)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->find((XsDevicePtr const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->insert((XsDevicePtr const &)*arg2,arg3);
+  
 }
 
 
-void _wrap_XsDevicePtrArray_removeDuplicates_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+void _wrap_XsDevicePtrArray_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< 1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->removeDuplicates();
+  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsDevicePtrArray_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsDevicePtrArray_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
-  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
+  XsDevicePtr *arg2 = (XsDevicePtr *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImplConst< -1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
-  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::const_reverse_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->removeDuplicatesPredicate(arg2);
+  (swig_b0)->insert((XsDevicePtr const *)arg2,arg3,arg4);
   
 }
 
 
-void _wrap_XsDevicePtrArray_sort_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+void _wrap_XsDevicePtrArray_push_back_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->sort();
+  (swig_b0)->push_back((XsDevicePtr const &)*arg2);
   
 }
 
 
-void _wrap_XsDevicePtrArray_reverse_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+void _wrap_XsDevicePtrArray_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
   XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
-  (swig_b0)->reverse();
+  (swig_b0)->pop_back(arg2);
   
 }
 
 
-void _wrap_XsCallbackPlainC_m_onDeviceStateChanged_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
+void _wrap_XsDevicePtrArray_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
-  if (arg1) (arg1)->m_onDeviceStateChanged = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->pop_back();
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onDeviceStateChanged_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_push_front_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->push_front((XsDevicePtr const &)*arg2);
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((arg1)->m_onDeviceStateChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onLiveDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+void _wrap_XsDevicePtrArray_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->m_onLiveDataAvailable = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->pop_front(arg2);
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onLiveDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->pop_front();
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onMissedPackets_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
-  
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
+long long _wrap_XsDevicePtrArray_size_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
-  if (arg1) (arg1)->m_onMissedPackets = arg2;
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = ((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->size();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onMissedPackets_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->erase(arg2,arg3);
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((arg1)->m_onMissedPackets);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onWakeupReceived_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
+void _wrap_XsDevicePtrArray_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->m_onWakeupReceived = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->erase(arg2);
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onWakeupReceived_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_wrap_XsDevicePtrArray_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > arg2 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((arg1)->m_onWakeupReceived);
-  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator");
+  }
+  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator)*argp2;
+  
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (swig_b0)->erase(arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onProgressUpdated_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_wrap_XsDevicePtrArray_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > arg2 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  
+  argp2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator");
+  }
+  arg2 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator)*argp2;
   
-  if (arg1) (arg1)->m_onProgressUpdated = arg2;
   
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (swig_b0)->erase(arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onProgressUpdated_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_assign_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, XsDevicePtr *_swig_go_2) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
+  XsDevicePtr *arg3 = (XsDevicePtr *) 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = *(XsDevicePtr **)&_swig_go_2; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->assign(arg2,(XsDevicePtr const *)arg3);
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((arg1)->m_onProgressUpdated);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onWriteMessageToLogFile_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+void _wrap_XsDevicePtrArray_resize_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->m_onWriteMessageToLogFile = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->resize(arg2);
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onWriteMessageToLogFile_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_setSize_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->setSize(arg2);
   
-  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onWriteMessageToLogFile);
-  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onBufferedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+void _wrap_XsDevicePtrArray_append_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onBufferedDataAvailable = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->append((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onBufferedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_wrap_XsDevicePtrArray_opAssign_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *result = 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *) &(swig_b0)->operator =((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl const &)*arg2);
+  *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onConnectivityChanged_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
-  
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
+bool _wrap_XsDevicePtrArray_empty_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  if (arg1) (arg1)->m_onConnectivityChanged = arg2;
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (bool)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->empty();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onConnectivityChanged_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
-  void* _swig_go_result;
+XsDevicePtrArray *_wrap_XsDevicePtrArray_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((arg1)->m_onConnectivityChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (XsDevicePtrArray *) &((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->inherited();
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onInfoResponse_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
-  
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
+XsDevicePtrArray *_wrap_XsDevicePtrArray_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  if (arg1) (arg1)->m_onInfoResponse = arg2;
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (XsDevicePtrArray *) &(swig_b0)->inherited();
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onInfoResponse_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl *arg2 = 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray >::ArrayImpl **)&_swig_go_1; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->swap(*arg2);
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((arg1)->m_onInfoResponse);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onError_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
+void _wrap_XsDevicePtrArray_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
-  if (arg1) (arg1)->m_onError = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->swap(arg2,arg3);
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onError_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
-  void* _swig_go_result;
+XsDevicePtrArray *_wrap_XsDevicePtrArray_opLeftShift_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  XsDevicePtrArray *result = 0 ;
+  XsDevicePtrArray *_swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((arg1)->m_onError);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (XsDevicePtrArray *) &(swig_b0)->operator <<((XsDevicePtr const &)*arg2);
+  *(XsDevicePtrArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onNonDataMessage_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+intgo _wrap_XsDevicePtrArray_find_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, XsDevicePtr *_swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsDevicePtr *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsDevicePtr **)&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onNonDataMessage = arg2;
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  result = (int)((XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > const *)swig_b0)->find((XsDevicePtr const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XsDevicePtrArray_removeDuplicates_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->removeDuplicates();
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onNonDataMessage_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
-  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsDevicePtrArray_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0, void* _swig_go_1) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
   
-  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->removeDuplicatesPredicate(arg2);
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onNonDataMessage);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onMessageDetected_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsDevicePtrArray_sort_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->sort();
+  
+}
+
+
+void _wrap_XsDevicePtrArray_reverse_gen_be9d2f14c67e6fa7(XsDevicePtrArray *_swig_go_0) {
+  XsDevicePtrArray *arg1 = (XsDevicePtrArray *) 0 ;
+  
+  arg1 = *(XsDevicePtrArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *swig_b0 = (XsArrayImpl< XsDevicePtr,g_xsDevicePtrArrayDescriptor,XsDevicePtrArray > *)arg1;
+  (swig_b0)->reverse();
+  
+}
+
+
+void _wrap_XsCallbackPlainC_m_onDeviceStateChanged_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onMessageDetected = arg2;
+  if (arg1) (arg1)->m_onDeviceStateChanged = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onMessageDetected_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onDeviceStateChanged_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((arg1)->m_onMessageDetected);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((arg1)->m_onDeviceStateChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onMessageReceivedFromDevice_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onLiveDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onMessageReceivedFromDevice = arg2;
+  if (arg1) (arg1)->m_onLiveDataAvailable = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onMessageReceivedFromDevice_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onLiveDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onMessageReceivedFromDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onMessageSentToDevice_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onMissedPackets_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onMessageSentToDevice = arg2;
+  if (arg1) (arg1)->m_onMissedPackets = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onMessageSentToDevice_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onMissedPackets_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onMessageSentToDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((arg1)->m_onMissedPackets);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onAllLiveDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onWakeupReceived_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onAllLiveDataAvailable = arg2;
+  if (arg1) (arg1)->m_onWakeupReceived = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onAllLiveDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onWakeupReceived_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((arg1)->m_onWakeupReceived);
+  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onAllBufferedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onProgressUpdated_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onAllBufferedDataAvailable = arg2;
+  if (arg1) (arg1)->m_onProgressUpdated = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onAllBufferedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onProgressUpdated_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((arg1)->m_onProgressUpdated);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onDataUnavailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onWriteMessageToLogFile_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
+  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
+  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onDataUnavailable = arg2;
+  if (arg1) (arg1)->m_onWriteMessageToLogFile = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onDataUnavailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onWriteMessageToLogFile_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
+  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((arg1)->m_onDataUnavailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
+  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onWriteMessageToLogFile);
+  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onBufferedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
   void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onDataAvailable = arg2;
+  if (arg1) (arg1)->m_onBufferedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onBufferedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
   void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onDataAvailable);
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onBufferedDataAvailable);
   *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onAllDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onConnectivityChanged_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onAllDataAvailable = arg2;
+  if (arg1) (arg1)->m_onConnectivityChanged = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onAllDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onConnectivityChanged_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((arg1)->m_onConnectivityChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onRecordedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onInfoResponse_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onRecordedDataAvailable = arg2;
+  if (arg1) (arg1)->m_onInfoResponse = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onRecordedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onInfoResponse_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((arg1)->m_onInfoResponse);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onAllRecordedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onError_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onAllRecordedDataAvailable = arg2;
+  if (arg1) (arg1)->m_onError = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onAllRecordedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onError_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((arg1)->m_onError);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onTransmissionRequest_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onNonDataMessage_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onTransmissionRequest = arg2;
+  if (arg1) (arg1)->m_onNonDataMessage = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onTransmissionRequest_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onNonDataMessage_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((arg1)->m_onTransmissionRequest);
-  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onNonDataMessage);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsCallbackPlainC_m_onRestoreCommunication_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+void _wrap_XsCallbackPlainC_m_onMessageDetected_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
   
-  if (arg1) (arg1)->m_onRestoreCommunication = arg2;
+  if (arg1) (arg1)->m_onMessageDetected = arg2;
   
 }
 
 
-void* _wrap_XsCallbackPlainC_m_onRestoreCommunication_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+void* _wrap_XsCallbackPlainC_m_onMessageDetected_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
   XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((arg1)->m_onRestoreCommunication);
-  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((arg1)->m_onMessageDetected);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-XsCallback *_wrap_new_XsCallback_gen_be9d2f14c67e6fa7() {
-  XsCallback *result = 0 ;
-  XsCallback *_swig_go_result;
+void _wrap_XsCallbackPlainC_m_onMessageReceivedFromDevice_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  
+  if (arg1) (arg1)->m_onMessageReceivedFromDevice = arg2;
   
-  result = (XsCallback *)new XsCallback();
-  *(XsCallback **)&_swig_go_result = (XsCallback *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_XsCallback_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  
-  arg1 = *(XsCallback **)&_swig_go_0; 
+void* _wrap_XsCallbackPlainC_m_onMessageReceivedFromDevice_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
-  delete arg1;
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onMessageReceivedFromDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
+void _wrap_XsCallbackPlainC_m_onMessageSentToDevice_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onDeviceStateChanged = arg2;
+  if (arg1) (arg1)->m_onMessageSentToDevice = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onMessageSentToDevice_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b0)->m_onDeviceStateChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((arg1)->m_onMessageSentToDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+void _wrap_XsCallbackPlainC_m_onAllLiveDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onLiveDataAvailable = arg2;
+  if (arg1) (arg1)->m_onAllLiveDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onAllLiveDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
+void _wrap_XsCallbackPlainC_m_onAllBufferedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onMissedPackets = arg2;
+  if (arg1) (arg1)->m_onAllBufferedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onAllBufferedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b0)->m_onMissedPackets);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
+void _wrap_XsCallbackPlainC_m_onDataUnavailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onWakeupReceived = arg2;
+  if (arg1) (arg1)->m_onDataUnavailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onDataUnavailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b0)->m_onWakeupReceived);
-  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((arg1)->m_onDataUnavailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
+void _wrap_XsCallbackPlainC_m_onDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onProgressUpdated = arg2;
+  if (arg1) (arg1)->m_onDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b0)->m_onProgressUpdated);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+void _wrap_XsCallbackPlainC_m_onAllDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onWriteMessageToLogFile = arg2;
+  if (arg1) (arg1)->m_onAllDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onAllDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onWriteMessageToLogFile);
-  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
+void _wrap_XsCallbackPlainC_m_onRecordedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
   void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onBufferedDataAvailable = arg2;
+  if (arg1) (arg1)->m_onRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
+void* _wrap_XsCallbackPlainC_m_onRecordedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
   void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onBufferedDataAvailable);
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((arg1)->m_onRecordedDataAvailable);
   *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
+void _wrap_XsCallbackPlainC_m_onAllRecordedDataAvailable_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onConnectivityChanged = arg2;
+  if (arg1) (arg1)->m_onAllRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onAllRecordedDataAvailable_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b0)->m_onConnectivityChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((arg1)->m_onAllRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
+void _wrap_XsCallbackPlainC_m_onTransmissionRequest_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onInfoResponse = arg2;
+  if (arg1) (arg1)->m_onTransmissionRequest = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onTransmissionRequest_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b0)->m_onInfoResponse);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
+  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((arg1)->m_onTransmissionRequest);
+  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onError_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
+void _wrap_XsCallbackPlainC_m_onRestoreCommunication_set_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0, void* _swig_go_1) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onError = arg2;
+  if (arg1) (arg1)->m_onRestoreCommunication = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onError_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
+void* _wrap_XsCallbackPlainC_m_onRestoreCommunication_get_gen_be9d2f14c67e6fa7(XsCallbackPlainC *_swig_go_0) {
+  XsCallbackPlainC *arg1 = (XsCallbackPlainC *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
   void* _swig_go_result;
   
-  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg1 = *(XsCallbackPlainC **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b0)->m_onError);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
+  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((arg1)->m_onRestoreCommunication);
+  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
-  XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
-  
-  arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+XsCallback *_wrap_new_XsCallback_gen_be9d2f14c67e6fa7() {
+  XsCallback *result = 0 ;
+  XsCallback *_swig_go_result;
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onNonDataMessage = arg2;
   
+  result = (XsCallback *)new XsCallback();
+  *(XsCallback **)&_swig_go_result = (XsCallback *)result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsCallback_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void _wrap_delete_XsCallback_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
-  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onNonDataMessage);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
-  return _swig_go_result;
+  delete arg1;
+  
 }
 
 
-void _wrap_SetXsCallback_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onMessageDetected = arg2;
+  if (swig_b0) (swig_b0)->m_onDeviceStateChanged = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b0)->m_onMessageDetected);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b0)->m_onDeviceStateChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onMessageReceivedFromDevice = arg2;
+  if (swig_b0) (swig_b0)->m_onLiveDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onMessageReceivedFromDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onMessageSentToDevice = arg2;
+  if (swig_b0) (swig_b0)->m_onMissedPackets = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onMessageSentToDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b0)->m_onMissedPackets);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onAllLiveDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onWakeupReceived = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b0)->m_onWakeupReceived);
+  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onAllBufferedDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onProgressUpdated = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b0)->m_onProgressUpdated);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
+  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
+  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onDataUnavailable = arg2;
+  if (swig_b0) (swig_b0)->m_onWriteMessageToLogFile = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
+  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b0)->m_onDataUnavailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
+  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onWriteMessageToLogFile);
+  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
   void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
@@ -5366,12 +5704,12 @@ void _wrap_SetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swi
   arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onBufferedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
   void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
@@ -5379,163 +5717,460 @@ void* _wrap_GetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_sw
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onDataAvailable);
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onBufferedDataAvailable);
   *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onAllDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onConnectivityChanged = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b0)->m_onConnectivityChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onRecordedDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onInfoResponse = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b0)->m_onInfoResponse);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onError_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onAllRecordedDataAvailable = arg2;
+  if (swig_b0) (swig_b0)->m_onError = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onError_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b0)->m_onError);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onTransmissionRequest = arg2;
+  if (swig_b0) (swig_b0)->m_onNonDataMessage = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b0)->m_onTransmissionRequest);
-  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onNonDataMessage);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsCallback_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsCallback_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  if (swig_b0) (swig_b0)->m_onRestoreCommunication = arg2;
+  if (swig_b0) (swig_b0)->m_onMessageDetected = arg2;
   
 }
 
 
-void* _wrap_GetXsCallback_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+void* _wrap_GetXsCallback_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
   XsCallback *arg1 = (XsCallback *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsCallback **)&_swig_go_0; 
   
   XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
-  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b0)->m_onRestoreCommunication);
-  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b0)->m_onMessageDetected);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_CallbackManagerXda_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackManagerXda *_swig_go_0, XsDevice *_swig_go_1, XsDeviceState *_swig_go_2, XsDeviceState *_swig_go_3) {
-  CallbackManagerXda *arg1 = (CallbackManagerXda *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDeviceState arg3 ;
-  XsDeviceState arg4 ;
-  XsDeviceState *argp3 ;
-  XsDeviceState *argp4 ;
+void _wrap_SetXsCallback_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(CallbackManagerXda **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  argp3 = (XsDeviceState *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsDeviceState");
-  }
-  arg3 = (XsDeviceState)*argp3;
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onMessageReceivedFromDevice = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onMessageReceivedFromDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onMessageSentToDevice = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b0)->m_onMessageSentToDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onAllLiveDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onAllBufferedDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onDataUnavailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b0)->m_onDataUnavailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onAllDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onRecordedDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b0)->m_onRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onAllRecordedDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b0)->m_onAllRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onTransmissionRequest = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b0)->m_onTransmissionRequest);
+  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetXsCallback_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0, void* _swig_go_1) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  if (swig_b0) (swig_b0)->m_onRestoreCommunication = arg2;
+  
+}
+
+
+void* _wrap_GetXsCallback_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsCallback *_swig_go_0) {
+  XsCallback *arg1 = (XsCallback *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
+  void* _swig_go_result;
+  
+  arg1 = *(XsCallback **)&_swig_go_0; 
+  
+  XsCallbackPlainC *swig_b0 = (XsCallbackPlainC *)arg1;
+  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b0)->m_onRestoreCommunication);
+  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_CallbackManagerXda_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackManagerXda *_swig_go_0, XsDevice *_swig_go_1, XsDeviceState *_swig_go_2, XsDeviceState *_swig_go_3) {
+  CallbackManagerXda *arg1 = (CallbackManagerXda *) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDeviceState arg3 ;
+  XsDeviceState arg4 ;
+  XsDeviceState *argp3 ;
+  XsDeviceState *argp4 ;
+  
+  arg1 = *(CallbackManagerXda **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  
+  argp3 = (XsDeviceState *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsDeviceState");
+  }
+  arg3 = (XsDeviceState)*argp3;
   
   
   argp4 = (XsDeviceState *)_swig_go_3;
@@ -22424,2738 +23059,3079 @@ bool _wrap_XSDataPacket_containsTriggerIndication_gen_be9d2f14c67e6fa7(XsDataPac
   arg2 = (XsDataIdentifier)*argp2;
   
   
-  result = (bool)((XsDataPacket const *)arg1)->containsTriggerIndication(arg2);
+  result = (bool)((XsDataPacket const *)arg1)->containsTriggerIndication(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+XsTriggerIndicationData *_wrap_XSDataPacket_triggerIndication_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsDataIdentifier *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsDataIdentifier arg2 ;
+  XsDataIdentifier *argp2 ;
+  XsTriggerIndicationData result;
+  XsTriggerIndicationData *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  argp2 = (XsDataIdentifier *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsDataIdentifier");
+  }
+  arg2 = (XsDataIdentifier)*argp2;
+  
+  
+  result = (arg1)->triggerIndication(arg2);
+  *(XsTriggerIndicationData **)&_swig_go_result = new XsTriggerIndicationData(result); 
+  return _swig_go_result;
+}
+
+
+XsByteArray *_wrap_XSDataPacket_rawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsByteArray result;
+  XsByteArray *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = ((XsDataPacket const *)arg1)->rawBlob();
+  *(XsByteArray **)&_swig_go_result = new XsByteArray(result); 
+  return _swig_go_result;
+}
+
+
+bool _wrap_XSDataPacket_containsRawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (bool)((XsDataPacket const *)arg1)->containsRawBlob();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_setRawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsByteArray *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsByteArray *arg2 = 0 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = *(XsByteArray **)&_swig_go_1; 
+  
+  (arg1)->setRawBlob((XsByteArray const &)*arg2);
+  
+}
+
+
+XsVector *_wrap_XSDataPacket_accelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsVector result;
+  XsVector *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = ((XsDataPacket const *)arg1)->accelerationHR();
+  *(XsVector **)&_swig_go_result = new XsVector(result); 
+  return _swig_go_result;
+}
+
+
+bool _wrap_XSDataPacket_containsAccelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (bool)((XsDataPacket const *)arg1)->containsAccelerationHR();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_setAccelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsVector *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsVector *arg2 = 0 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = *(XsVector **)&_swig_go_1; 
+  
+  (arg1)->setAccelerationHR((XsVector const &)*arg2);
+  
+}
+
+
+XsVector *_wrap_XSDataPacket_rateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsVector result;
+  XsVector *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = ((XsDataPacket const *)arg1)->rateOfTurnHR();
+  *(XsVector **)&_swig_go_result = new XsVector(result); 
+  return _swig_go_result;
+}
+
+
+bool _wrap_XSDataPacket_containsRateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (bool)((XsDataPacket const *)arg1)->containsRateOfTurnHR();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_setRateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsVector *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsVector *arg2 = 0 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = *(XsVector **)&_swig_go_1; 
+  
+  (arg1)->setRateOfTurnHR((XsVector const &)*arg2);
+  
+}
+
+
+std::map< XsDataIdentifier,void * > *_wrap_XSDataPacket_simplifiedContents_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  std::map< XsDataIdentifier,void * > result;
+  std::map< XsDataIdentifier,void * > *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = ((XsDataPacket const *)arg1)->simplifiedContents();
+  *(std::map< XsDataIdentifier,void * > **)&_swig_go_result = new std::map< XsDataIdentifier,void * >(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_d_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, DataPacketPrivate *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  DataPacketPrivate *arg2 = (DataPacketPrivate *) 0 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = *(DataPacketPrivate **)&_swig_go_1; 
+  
+  if (arg1) (arg1)->d = arg2;
+  
+}
+
+
+DataPacketPrivate *_wrap_XSDataPacket_d_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  DataPacketPrivate *result = 0 ;
+  DataPacketPrivate *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (DataPacketPrivate *) ((arg1)->d);
+  *(DataPacketPrivate **)&_swig_go_result = (DataPacketPrivate *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_m_deviceId_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsDeviceId *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsDeviceId *arg2 = (XsDeviceId *) 0 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
+  
+  if (arg1) (arg1)->m_deviceId = *arg2;
+  
+}
+
+
+XsDeviceId *_wrap_XSDataPacket_m_deviceId_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsDeviceId *result = 0 ;
+  XsDeviceId *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (XsDeviceId *)& ((arg1)->m_deviceId);
+  *(XsDeviceId **)&_swig_go_result = (XsDeviceId *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_m_toa_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsTimeStamp *_swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsTimeStamp arg2 ;
+  XsTimeStamp *argp2 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  argp2 = (XsTimeStamp *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsTimeStamp");
+  }
+  arg2 = (XsTimeStamp)*argp2;
+  
+  
+  if (arg1) (arg1)->m_toa = arg2;
+  
+}
+
+
+XsTimeStamp *_wrap_XSDataPacket_m_toa_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  XsTimeStamp result;
+  XsTimeStamp *_swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result =  ((arg1)->m_toa);
+  *(XsTimeStamp **)&_swig_go_result = new XsTimeStamp(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_XSDataPacket_m_packetId_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, long long _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int64_t arg2 ;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int64_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->m_packetId = arg2;
+  
+}
+
+
+long long _wrap_XSDataPacket_m_packetId_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  
+  result = (int64_t) ((arg1)->m_packetId);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsTriggerIndicationData *_wrap_XSDataPacket_triggerIndication_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsDataIdentifier *_swig_go_1) {
+void _wrap_XSDataPacket_m_etos_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsTimeStamp *_swig_go_1) {
   XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsDataIdentifier arg2 ;
-  XsDataIdentifier *argp2 ;
-  XsTriggerIndicationData result;
-  XsTriggerIndicationData *_swig_go_result;
+  XsTimeStamp arg2 ;
+  XsTimeStamp *argp2 ;
   
   arg1 = *(XsDataPacket **)&_swig_go_0; 
   
-  argp2 = (XsDataIdentifier *)_swig_go_1;
+  argp2 = (XsTimeStamp *)_swig_go_1;
   if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsDataIdentifier");
+    _swig_gopanic("Attempt to dereference null XsTimeStamp");
   }
-  arg2 = (XsDataIdentifier)*argp2;
+  arg2 = (XsTimeStamp)*argp2;
   
   
-  result = (arg1)->triggerIndication(arg2);
-  *(XsTriggerIndicationData **)&_swig_go_result = new XsTriggerIndicationData(result); 
-  return _swig_go_result;
+  if (arg1) (arg1)->m_etos = arg2;
+  
 }
 
 
-XsByteArray *_wrap_XSDataPacket_rawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+XsTimeStamp *_wrap_XSDataPacket_m_etos_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
   XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsByteArray result;
-  XsByteArray *_swig_go_result;
+  XsTimeStamp result;
+  XsTimeStamp *_swig_go_result;
   
   arg1 = *(XsDataPacket **)&_swig_go_0; 
   
-  result = ((XsDataPacket const *)arg1)->rawBlob();
-  *(XsByteArray **)&_swig_go_result = new XsByteArray(result); 
+  result =  ((arg1)->m_etos);
+  *(XsTimeStamp **)&_swig_go_result = new XsTimeStamp(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSDataPacket_containsRawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  bool result;
-  bool _swig_go_result;
+void _wrap_XsQuaternion_destruct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (bool)((XsDataPacket const *)arg1)->containsRawBlob();
+  XsQuaternion_destruct(arg1);
+  
+}
+
+
+intgo _wrap_XsQuaternion_empty_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  
+  result = (int)XsQuaternion_empty((XsQuaternion const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_setRawBlob_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsByteArray *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsByteArray *arg2 = 0 ;
+void _wrap_XsQuaternion_inverse_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = *(XsByteArray **)&_swig_go_1; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  (arg1)->setRawBlob((XsByteArray const &)*arg2);
+  XsQuaternion_inverse((XsQuaternion const *)arg1,arg2);
   
 }
 
 
-XsVector *_wrap_XSDataPacket_accelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsVector result;
-  XsVector *_swig_go_result;
+double _wrap_XsQuaternion_normalized_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = ((XsDataPacket const *)arg1)->accelerationHR();
-  *(XsVector **)&_swig_go_result = new XsVector(result); 
+  result = (XsReal)XsQuaternion_normalized((XsQuaternion const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSDataPacket_containsAccelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  bool result;
-  bool _swig_go_result;
+double _wrap_XsQuaternion_normalize_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (bool)((XsDataPacket const *)arg1)->containsAccelerationHR();
+  result = (XsReal)XsQuaternion_normalize(arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_setAccelerationHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsVector *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsVector *arg2 = 0 ;
+void _wrap_XsQuaternion_fromEulerAngles_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsEuler *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsEuler *arg2 = (XsEuler *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = *(XsVector **)&_swig_go_1; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
   
-  (arg1)->setAccelerationHR((XsVector const &)*arg2);
+  XsQuaternion_fromEulerAngles(arg1,(XsEuler const *)arg2);
   
 }
 
 
-XsVector *_wrap_XSDataPacket_rateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsVector result;
-  XsVector *_swig_go_result;
+void _wrap_XsQuaternion_fromRotationMatrix_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsMatrix *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsMatrix *arg2 = (XsMatrix *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsMatrix **)&_swig_go_1; 
+  
+  XsQuaternion_fromRotationMatrix(arg1,(XsMatrix const *)arg2);
   
-  result = ((XsDataPacket const *)arg1)->rateOfTurnHR();
-  *(XsVector **)&_swig_go_result = new XsVector(result); 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XSDataPacket_containsRateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  bool result;
-  bool _swig_go_result;
+XsQuaternion *_wrap_XsQuaternion_identity_gen_be9d2f14c67e6fa7() {
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
   
-  result = (bool)((XsDataPacket const *)arg1)->containsRateOfTurnHR();
-  _swig_go_result = result; 
+  result = (XsQuaternion *)XsQuaternion_identity();
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_setRateOfTurnHR_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsVector *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsVector *arg2 = 0 ;
+void _wrap_XsQuaternion_multiply_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, XsQuaternion *_swig_go_2) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg3 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = *(XsVector **)&_swig_go_1; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg3 = *(XsQuaternion **)&_swig_go_2; 
   
-  (arg1)->setRateOfTurnHR((XsVector const &)*arg2);
+  XsQuaternion_multiply((XsQuaternion const *)arg1,(XsQuaternion const *)arg2,arg3);
   
 }
 
 
-std::map< XsDataIdentifier,void * > *_wrap_XSDataPacket_simplifiedContents_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  std::map< XsDataIdentifier,void * > result;
-  std::map< XsDataIdentifier,void * > *_swig_go_result;
+void _wrap_XsQuaternion_swap_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  
+  XsQuaternion_swap(arg1,arg2);
   
-  result = ((XsDataPacket const *)arg1)->simplifiedContents();
-  *(std::map< XsDataIdentifier,void * > **)&_swig_go_result = new std::map< XsDataIdentifier,void * >(result); 
-  return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_d_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, DataPacketPrivate *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  DataPacketPrivate *arg2 = (DataPacketPrivate *) 0 ;
+void _wrap_XsQuaternion_copy_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = *(DataPacketPrivate **)&_swig_go_1; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  if (arg1) (arg1)->d = arg2;
+  XsQuaternion_copy(arg1,(XsQuaternion const *)arg2);
   
 }
 
 
-DataPacketPrivate *_wrap_XSDataPacket_d_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  DataPacketPrivate *result = 0 ;
-  DataPacketPrivate *_swig_go_result;
+intgo _wrap_XsQuaternion_equal_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (DataPacketPrivate *) ((arg1)->d);
-  *(DataPacketPrivate **)&_swig_go_result = (DataPacketPrivate *)result; 
+  result = (int)XsQuaternion_equal((XsQuaternion const *)arg1,(XsQuaternion const *)arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_m_deviceId_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsDeviceId *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsDeviceId *arg2 = (XsDeviceId *) 0 ;
-  
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
+intgo _wrap_XsQuaternion_compare_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, double _swig_go_2) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsReal arg3 ;
+  int result;
+  intgo _swig_go_result;
   
-  if (arg1) (arg1)->m_deviceId = *arg2;
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
   
+  result = (int)XsQuaternion_compare((XsQuaternion const *)arg1,(XsQuaternion const *)arg2,arg3);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-XsDeviceId *_wrap_XSDataPacket_m_deviceId_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsDeviceId *result = 0 ;
-  XsDeviceId *_swig_go_result;
+double _wrap_XsQuaternion_dotProduct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsDeviceId *)& ((arg1)->m_deviceId);
-  *(XsDeviceId **)&_swig_go_result = (XsDeviceId *)result; 
+  result = (XsReal)XsQuaternion_dotProduct((XsQuaternion const *)arg1,(XsQuaternion const *)arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_m_toa_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsTimeStamp *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsTimeStamp arg2 ;
-  XsTimeStamp *argp2 ;
-  
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  
-  argp2 = (XsTimeStamp *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsTimeStamp");
-  }
-  arg2 = (XsTimeStamp)*argp2;
-  
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_0_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3) {
+  XsReal arg1 ;
+  XsReal arg2 ;
+  XsReal arg3 ;
+  XsReal arg4 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  if (arg1) (arg1)->m_toa = arg2;
+  arg1 = (XsReal)_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
+  arg4 = (XsReal)_swig_go_3; 
   
+  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3,arg4);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  return _swig_go_result;
 }
 
 
-XsTimeStamp *_wrap_XSDataPacket_m_toa_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsTimeStamp result;
-  XsTimeStamp *_swig_go_result;
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_1_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2) {
+  XsReal arg1 ;
+  XsReal arg2 ;
+  XsReal arg3 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = (XsReal)_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
   
-  result =  ((arg1)->m_toa);
-  *(XsTimeStamp **)&_swig_go_result = new XsTimeStamp(result); 
+  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_m_packetId_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, long long _swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  int64_t arg2 ;
-  
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  arg2 = (int64_t)_swig_go_1; 
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_2_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1) {
+  XsReal arg1 ;
+  XsReal arg2 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  if (arg1) (arg1)->m_packetId = arg2;
+  arg1 = (XsReal)_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
   
+  result = (XsQuaternion *)new XsQuaternion(arg1,arg2);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  return _swig_go_result;
 }
 
 
-long long _wrap_XSDataPacket_m_packetId_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  int64_t result;
-  long long _swig_go_result;
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_3_gen_be9d2f14c67e6fa7(double _swig_go_0) {
+  XsReal arg1 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = (XsReal)_swig_go_0; 
   
-  result = (int64_t) ((arg1)->m_packetId);
-  _swig_go_result = result; 
+  result = (XsQuaternion *)new XsQuaternion(arg1);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSDataPacket_m_etos_set_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, XsTimeStamp *_swig_go_1) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsTimeStamp arg2 ;
-  XsTimeStamp *argp2 ;
-  
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
-  
-  argp2 = (XsTimeStamp *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsTimeStamp");
-  }
-  arg2 = (XsTimeStamp)*argp2;
-  
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_4_gen_be9d2f14c67e6fa7() {
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  if (arg1) (arg1)->m_etos = arg2;
   
+  result = (XsQuaternion *)new XsQuaternion();
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  return _swig_go_result;
 }
 
 
-XsTimeStamp *_wrap_XSDataPacket_m_etos_get_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
-  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
-  XsTimeStamp result;
-  XsTimeStamp *_swig_go_result;
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_5_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3, bool _swig_go_4) {
+  XsReal arg1 ;
+  XsReal arg2 ;
+  XsReal arg3 ;
+  XsReal arg4 ;
+  bool arg5 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg1 = (XsReal)_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
+  arg4 = (XsReal)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
   
-  result =  ((arg1)->m_etos);
-  *(XsTimeStamp **)&_swig_go_result = new XsTimeStamp(result); 
+  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3,arg4,arg5);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_destruct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_6_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = 0 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  XsQuaternion_destruct(arg1);
+  result = (XsQuaternion *)new XsQuaternion((XsQuaternion const &)*arg1);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  return _swig_go_result;
+}
+
+
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_7_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = 0 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
+  
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
+  result = (XsQuaternion *)new XsQuaternion((XsEuler const &)*arg1);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_XsQuaternion_empty_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  int result;
-  intgo _swig_go_result;
+XsQuaternion *_wrap_new_XSQuaternion__SWIG_8_gen_be9d2f14c67e6fa7(XsMatrix *_swig_go_0) {
+  XsMatrix *arg1 = 0 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsMatrix **)&_swig_go_0; 
   
-  result = (int)XsQuaternion_empty((XsQuaternion const *)arg1);
-  _swig_go_result = result; 
+  result = (XsQuaternion *)new XsQuaternion((XsMatrix const &)*arg1);
+  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_inverse_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+void _wrap_delete_XSQuaternion_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  XsQuaternion_inverse((XsQuaternion const *)arg1,arg2);
+  delete arg1;
   
 }
 
 
-double _wrap_XsQuaternion_normalized_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+XsQuaternion *_wrap_XSQuaternion_opAssign_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+  XsQuaternion *arg2 = 0 ;
+  XsQuaternion *result = 0 ;
+  XsQuaternion *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
   arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsReal)XsQuaternion_normalized((XsQuaternion const *)arg1,arg2);
-  _swig_go_result = result; 
+  result = (XsQuaternion *) &(arg1)->operator =((XsQuaternion const &)*arg2);
+  *(XsQuaternion **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XsQuaternion_normalize_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+void _wrap_XSQuaternion_assign__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3, double _swig_go_4) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+  XsReal arg2 ;
+  XsReal arg3 ;
+  XsReal arg4 ;
+  XsReal arg5 ;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
+  arg4 = (XsReal)_swig_go_3; 
+  arg5 = (XsReal)_swig_go_4; 
+  
+  (arg1)->assign(arg2,arg3,arg4,arg5);
   
-  result = (XsReal)XsQuaternion_normalize(arg1);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_fromEulerAngles_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsEuler *_swig_go_1) {
+void _wrap_XSQuaternion_assign__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, double *_swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsEuler *arg2 = (XsEuler *) 0 ;
+  XsReal *arg2 = (XsReal *) 0 ;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg2 = *(XsReal **)&_swig_go_1; 
   
-  XsQuaternion_fromEulerAngles(arg1,(XsEuler const *)arg2);
+  (arg1)->assign((XsReal const *)arg2);
   
 }
 
 
-void _wrap_XsQuaternion_fromRotationMatrix_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsMatrix *_swig_go_1) {
+XsReal *_wrap_XSQuaternion_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, long long _swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsMatrix *arg2 = (XsMatrix *) 0 ;
+  XsSize arg2 ;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsMatrix **)&_swig_go_1; 
-  
-  XsQuaternion_fromRotationMatrix(arg1,(XsMatrix const *)arg2);
+  arg2 = (size_t)_swig_go_1; 
   
+  result = (XsReal *) &(arg1)->operator [](arg2);
+  *(XsReal **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XsQuaternion_identity_gen_be9d2f14c67e6fa7() {
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+double _wrap_XSQuaternion_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, long long _swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsSize arg2 ;
+  XsReal result;
+  double _swig_go_result;
   
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsQuaternion *)XsQuaternion_identity();
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->operator [](arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_multiply_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, XsQuaternion *_swig_go_2) {
+double *_wrap_XSQuaternion_data_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg3 = (XsQuaternion *) 0 ;
+  XsReal *result = 0 ;
+  double *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
-  arg3 = *(XsQuaternion **)&_swig_go_2; 
-  
-  XsQuaternion_multiply((XsQuaternion const *)arg1,(XsQuaternion const *)arg2,arg3);
   
+  result = (XsReal *)((XsQuaternion const *)arg1)->data();
+  *(XsReal **)&_swig_go_result = (XsReal *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_swap_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+XsQuaternion *_wrap_XSQuaternion_inverse_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsQuaternion result;
+  XsQuaternion *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
-  
-  XsQuaternion_swap(arg1,arg2);
   
+  result = ((XsQuaternion const *)arg1)->inverse();
+  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsQuaternion_copy_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+XsQuaternion *_wrap_XSQuaternion_conjugate_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
+  XsQuaternion result;
+  XsQuaternion *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
-  
-  XsQuaternion_copy(arg1,(XsQuaternion const *)arg2);
   
+  result = ((XsQuaternion const *)arg1)->conjugate();
+  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_XsQuaternion_equal_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+XsQuaternion *_wrap_XSQuaternion_normalized_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  XsQuaternion result;
+  XsQuaternion *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (int)XsQuaternion_equal((XsQuaternion const *)arg1,(XsQuaternion const *)arg2);
-  _swig_go_result = result; 
+  result = ((XsQuaternion const *)arg1)->normalized();
+  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_XsQuaternion_compare_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, double _swig_go_2) {
+double _wrap_XSQuaternion_normalize_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
-  XsReal arg3 ;
-  int result;
-  intgo _swig_go_result;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (int)XsQuaternion_compare((XsQuaternion const *)arg1,(XsQuaternion const *)arg2,arg3);
+  result = (XsReal)(arg1)->normalize();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XsQuaternion_dotProduct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+bool _wrap_XSQuaternion_empty_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsReal)XsQuaternion_dotProduct((XsQuaternion const *)arg1,(XsQuaternion const *)arg2);
+  result = (bool)((XsQuaternion const *)arg1)->empty();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_0_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3) {
-  XsReal arg1 ;
-  XsReal arg2 ;
-  XsReal arg3 ;
-  XsReal arg4 ;
+XsQuaternion *_wrap_XSQuaternion_fromEulerAngles_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsEuler *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsEuler *arg2 = 0 ;
   XsQuaternion *result = 0 ;
   XsQuaternion *_swig_go_result;
   
-  arg1 = (XsReal)_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
-  arg4 = (XsReal)_swig_go_3; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
   
-  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3,arg4);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsQuaternion *) &(arg1)->fromEulerAngles((XsEuler const &)*arg2);
+  *(XsQuaternion **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_1_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2) {
-  XsReal arg1 ;
-  XsReal arg2 ;
-  XsReal arg3 ;
+XsQuaternion *_wrap_XSQuaternion_fromRotationMatrix_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsMatrix *_swig_go_1) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsMatrix *arg2 = 0 ;
   XsQuaternion *result = 0 ;
   XsQuaternion *_swig_go_result;
   
-  arg1 = (XsReal)_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsMatrix **)&_swig_go_1; 
   
-  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsQuaternion *) &(arg1)->fromRotationMatrix((XsMatrix const &)*arg2);
+  *(XsQuaternion **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_2_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1) {
-  XsReal arg1 ;
-  XsReal arg2 ;
+XsQuaternion *_wrap_XSQuaternion_identity_gen_be9d2f14c67e6fa7() {
   XsQuaternion *result = 0 ;
   XsQuaternion *_swig_go_result;
   
-  arg1 = (XsReal)_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
   
-  result = (XsQuaternion *)new XsQuaternion(arg1,arg2);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsQuaternion *) &XsQuaternion::identity();
+  *(XsQuaternion **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_3_gen_be9d2f14c67e6fa7(double _swig_go_0) {
-  XsReal arg1 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+double _wrap_XSQuaternion_w__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = (XsReal)_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion(arg1);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->w();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_4_gen_be9d2f14c67e6fa7() {
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+double _wrap_XSQuaternion_x__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion();
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->x();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_5_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3, bool _swig_go_4) {
-  XsReal arg1 ;
-  XsReal arg2 ;
-  XsReal arg3 ;
-  XsReal arg4 ;
-  bool arg5 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+double _wrap_XSQuaternion_y__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = (XsReal)_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
-  arg4 = (XsReal)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion(arg1,arg2,arg3,arg4,arg5);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->y();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_6_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+double _wrap_XSQuaternion_z__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion((XsQuaternion const &)*arg1);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->z();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_7_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+XsReal *_wrap_XSQuaternion_w__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion((XsEuler const &)*arg1);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal *) &(arg1)->w();
+  *(XsReal **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_new_XSQuaternion__SWIG_8_gen_be9d2f14c67e6fa7(XsMatrix *_swig_go_0) {
-  XsMatrix *arg1 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+XsReal *_wrap_XSQuaternion_x__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
-  arg1 = *(XsMatrix **)&_swig_go_0; 
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *)new XsQuaternion((XsMatrix const &)*arg1);
-  *(XsQuaternion **)&_swig_go_result = (XsQuaternion *)result; 
+  result = (XsReal *) &(arg1)->x();
+  *(XsReal **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_XSQuaternion_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+XsReal *_wrap_XSQuaternion_y__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  delete arg1;
-  
+  result = (XsReal *) &(arg1)->y();
+  *(XsReal **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_opAssign_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+XsReal *_wrap_XSQuaternion_z__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsQuaternion *) &(arg1)->operator =((XsQuaternion const &)*arg2);
-  *(XsQuaternion **)&_swig_go_result = result; 
+  result = (XsReal *) &(arg1)->z();
+  *(XsReal **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSQuaternion_assign__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, double _swig_go_1, double _swig_go_2, double _swig_go_3, double _swig_go_4) {
+void _wrap_XSQuaternion_swap_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal arg2 ;
-  XsReal arg3 ;
-  XsReal arg4 ;
-  XsReal arg5 ;
+  XsQuaternion *arg2 = 0 ;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
-  arg4 = (XsReal)_swig_go_3; 
-  arg5 = (XsReal)_swig_go_4; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  (arg1)->assign(arg2,arg3,arg4,arg5);
+  (arg1)->swap(*arg2);
   
 }
 
 
-void _wrap_XSQuaternion_assign__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, double *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal *arg2 = (XsReal *) 0 ;
+void _wrap_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsQuaternion *arg1 = 0 ;
+  XsQuaternion *arg2 = 0 ;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsReal **)&_swig_go_1; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  (arg1)->assign((XsReal const *)arg2);
+  swap(*arg1,*arg2);
   
 }
 
 
-XsReal *_wrap_XSQuaternion_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, long long _swig_go_1) {
+bool _wrap_XSQuaternion_opEq_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsSize arg2 ;
-  XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+  XsQuaternion *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsReal *) &(arg1)->operator [](arg2);
-  *(XsReal **)&_swig_go_result = result; 
+  result = (bool)((XsQuaternion const *)arg1)->operator ==((XsQuaternion const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, long long _swig_go_1) {
+bool _wrap_XSQuaternion_isEqual_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, double _swig_go_2) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsSize arg2 ;
-  XsReal result;
-  double _swig_go_result;
+  XsQuaternion *arg2 = 0 ;
+  XsReal arg3 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->operator [](arg2);
+  result = (bool)((XsQuaternion const *)arg1)->isEqual((XsQuaternion const &)*arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double *_wrap_XSQuaternion_data_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+double _wrap_XSQuaternion_dotProduct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
   XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal *result = 0 ;
-  double *_swig_go_result;
+  XsQuaternion *arg2 = 0 ;
+  XsReal result;
+  double _swig_go_result;
   
   arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsReal *)((XsQuaternion const *)arg1)->data();
-  *(XsReal **)&_swig_go_result = (XsReal *)result; 
+  result = (XsReal)((XsQuaternion const *)arg1)->dotProduct((XsQuaternion const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_inverse_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion result;
-  XsQuaternion *_swig_go_result;
+void _wrap_XsEuler_destruct_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  
+  XsEuler_destruct(arg1);
   
-  result = ((XsQuaternion const *)arg1)->inverse();
-  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
-  return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_conjugate_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion result;
-  XsQuaternion *_swig_go_result;
+intgo _wrap_XsEuler_empty_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = ((XsQuaternion const *)arg1)->conjugate();
-  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
+  result = (int)XsEuler_empty((XsEuler const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_normalized_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion result;
-  XsQuaternion *_swig_go_result;
+void _wrap_XsEuler_fromQuaternion_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  
+  XsEuler_fromQuaternion(arg1,(XsQuaternion const *)arg2);
   
-  result = ((XsQuaternion const *)arg1)->normalized();
-  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
-  return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_normalize_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+XsEuler *_wrap_new_XSEuler__SWIG_0_gen_be9d2f14c67e6fa7() {
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsReal)(arg1)->normalize();
-  _swig_go_result = result; 
+  result = (XsEuler *)new XsEuler();
+  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSQuaternion_empty_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  bool result;
-  bool _swig_go_result;
+XsEuler *_wrap_new_XSEuler__SWIG_1_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2) {
+  XsReal arg1 ;
+  XsReal arg2 ;
+  XsReal arg3 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = (XsReal)_swig_go_0; 
+  arg2 = (XsReal)_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
   
-  result = (bool)((XsQuaternion const *)arg1)->empty();
-  _swig_go_result = result; 
+  result = (XsEuler *)new XsEuler(arg1,arg2,arg3);
+  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_fromEulerAngles_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsEuler *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsEuler *arg2 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+XsEuler *_wrap_new_XSEuler__SWIG_2_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = 0 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (XsQuaternion *) &(arg1)->fromEulerAngles((XsEuler const &)*arg2);
-  *(XsQuaternion **)&_swig_go_result = result; 
+  result = (XsEuler *)new XsEuler((XsEuler const &)*arg1);
+  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_fromRotationMatrix_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsMatrix *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsMatrix *arg2 = 0 ;
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+XsEuler *_wrap_new_XSEuler__SWIG_3_gen_be9d2f14c67e6fa7(XsVector *_swig_go_0) {
+  XsVector *arg1 = 0 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsMatrix **)&_swig_go_1; 
+  arg1 = *(XsVector **)&_swig_go_0; 
   
-  result = (XsQuaternion *) &(arg1)->fromRotationMatrix((XsMatrix const &)*arg2);
-  *(XsQuaternion **)&_swig_go_result = result; 
+  result = (XsEuler *)new XsEuler((XsVector const &)*arg1);
+  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
   return _swig_go_result;
 }
 
 
-XsQuaternion *_wrap_XSQuaternion_identity_gen_be9d2f14c67e6fa7() {
-  XsQuaternion *result = 0 ;
-  XsQuaternion *_swig_go_result;
+XsEuler *_wrap_new_XSEuler__SWIG_4_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
+  XsQuaternion *arg1 = 0 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
+  arg1 = *(XsQuaternion **)&_swig_go_0; 
   
-  result = (XsQuaternion *) &XsQuaternion::identity();
-  *(XsQuaternion **)&_swig_go_result = result; 
+  result = (XsEuler *)new XsEuler((XsQuaternion const &)*arg1);
+  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_w__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+XsEuler *_wrap_XSEuler_opAssign_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsEuler *arg2 = 0 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->w();
-  _swig_go_result = result; 
+  result = (XsEuler *) &(arg1)->operator =((XsEuler const &)*arg2);
+  *(XsEuler **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_x__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+double _wrap_XSEuler_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, long long _swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsSize arg2 ;
   XsReal result;
   double _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->x();
+  result = (XsReal)((XsEuler const *)arg1)->operator [](arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_y__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+XsReal *_wrap_XSEuler_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, long long _swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsSize arg2 ;
+  XsReal *result = 0 ;
+  XsReal *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->y();
-  _swig_go_result = result; 
+  result = (XsReal *) &(arg1)->operator [](arg2);
+  *(XsReal **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_z__SWIG_0_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+bool _wrap_XSEuler_empty_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->z();
+  result = (bool)((XsEuler const *)arg1)->empty();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsReal *_wrap_XSQuaternion_w__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
+double *_wrap_XSEuler_data_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
   XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+  double *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (XsReal *) &(arg1)->w();
-  *(XsReal **)&_swig_go_result = result; 
+  result = (XsReal *)((XsEuler const *)arg1)->data();
+  *(XsReal **)&_swig_go_result = (XsReal *)result; 
   return _swig_go_result;
 }
 
 
-XsReal *_wrap_XSQuaternion_x__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+XsEuler *_wrap_XSEuler_fromQuaternion_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsQuaternion *_swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsQuaternion *arg2 = 0 ;
+  XsEuler *result = 0 ;
+  XsEuler *_swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  result = (XsReal *) &(arg1)->x();
-  *(XsReal **)&_swig_go_result = result; 
+  result = (XsEuler *) &(arg1)->fromQuaternion((XsQuaternion const &)*arg2);
+  *(XsEuler **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsReal *_wrap_XSQuaternion_y__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+bool _wrap_XSEuler_opEq_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsEuler *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
   
-  result = (XsReal *) &(arg1)->y();
-  *(XsReal **)&_swig_go_result = result; 
+  result = (bool)((XsEuler const *)arg1)->operator ==((XsEuler const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsReal *_wrap_XSQuaternion_z__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+bool _wrap_XSEuler_opNEq_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsEuler *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
   
-  result = (XsReal *) &(arg1)->z();
-  *(XsReal **)&_swig_go_result = result; 
+  result = (bool)((XsEuler const *)arg1)->operator !=((XsEuler const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XSQuaternion_swap_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = 0 ;
-  
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
+double _wrap_XSEuler_roll_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  (arg1)->swap(*arg2);
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
+  result = (XsReal)((XsEuler const *)arg1)->roll();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
-  XsQuaternion *arg1 = 0 ;
-  XsQuaternion *arg2 = 0 ;
-  
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
+double _wrap_XSEuler_pitch_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  swap(*arg1,*arg2);
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
+  result = (XsReal)((XsEuler const *)arg1)->pitch();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-bool _wrap_XSQuaternion_opEq_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+double _wrap_XSEuler_yaw_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (bool)((XsQuaternion const *)arg1)->operator ==((XsQuaternion const &)*arg2);
+  result = (XsReal)((XsEuler const *)arg1)->yaw();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSQuaternion_isEqual_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1, double _swig_go_2) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = 0 ;
-  XsReal arg3 ;
-  bool result;
-  bool _swig_go_result;
+double _wrap_XSEuler_x_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (bool)((XsQuaternion const *)arg1)->isEqual((XsQuaternion const &)*arg2,arg3);
+  result = (XsReal)((XsEuler const *)arg1)->x();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSQuaternion_dotProduct_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0, XsQuaternion *_swig_go_1) {
-  XsQuaternion *arg1 = (XsQuaternion *) 0 ;
-  XsQuaternion *arg2 = 0 ;
+double _wrap_XSEuler_y_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+  XsEuler *arg1 = (XsEuler *) 0 ;
   XsReal result;
   double _swig_go_result;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg1 = *(XsEuler **)&_swig_go_0; 
   
-  result = (XsReal)((XsQuaternion const *)arg1)->dotProduct((XsQuaternion const &)*arg2);
+  result = (XsReal)((XsEuler const *)arg1)->y();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsEuler_destruct_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+double _wrap_XSEuler_z_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
   XsEuler *arg1 = (XsEuler *) 0 ;
+  XsReal result;
+  double _swig_go_result;
   
   arg1 = *(XsEuler **)&_swig_go_0; 
   
-  XsEuler_destruct(arg1);
-  
+  result = (XsReal)((XsEuler const *)arg1)->z();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_XsEuler_empty_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
+bool _wrap_XSEuler_isEqual_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1, double _swig_go_2) {
   XsEuler *arg1 = (XsEuler *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  XsEuler *arg2 = 0 ;
+  XsReal arg3 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsEuler **)&_swig_go_0; 
+  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg3 = (XsReal)_swig_go_2; 
   
-  result = (int)XsEuler_empty((XsEuler const *)arg1);
+  result = (bool)((XsEuler const *)arg1)->isEqual((XsEuler const &)*arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsEuler_fromQuaternion_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsQuaternion *_swig_go_1) {
+void _wrap_delete_XSEuler_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
   XsEuler *arg1 = (XsEuler *) 0 ;
-  XsQuaternion *arg2 = (XsQuaternion *) 0 ;
   
   arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
   
-  XsEuler_fromQuaternion(arg1,(XsQuaternion const *)arg2);
+  delete arg1;
   
 }
 
 
-XsEuler *_wrap_new_XSEuler__SWIG_0_gen_be9d2f14c67e6fa7() {
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+XsControl *_wrap_new_XsControl_gen_be9d2f14c67e6fa7() {
+  XsControl *result = 0 ;
+  XsControl *_swig_go_result;
   
   
-  result = (XsEuler *)new XsEuler();
-  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
+  result = (XsControl *)new XsControl();
+  *(XsControl **)&_swig_go_result = (XsControl *)result; 
   return _swig_go_result;
 }
 
 
-XsEuler *_wrap_new_XSEuler__SWIG_1_gen_be9d2f14c67e6fa7(double _swig_go_0, double _swig_go_1, double _swig_go_2) {
-  XsReal arg1 ;
-  XsReal arg2 ;
-  XsReal arg3 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+void _wrap_delete_XsControl_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
   
-  arg1 = (XsReal)_swig_go_0; 
-  arg2 = (XsReal)_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (XsEuler *)new XsEuler(arg1,arg2,arg3);
-  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
-  return _swig_go_result;
 }
 
 
-XsEuler *_wrap_new_XSEuler__SWIG_2_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = 0 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+void _wrap_XsControl_flushInputBuffers_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  
+  (arg1)->flushInputBuffers();
   
-  result = (XsEuler *)new XsEuler((XsEuler const &)*arg1);
-  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
-  return _swig_go_result;
 }
 
 
-XsEuler *_wrap_new_XSEuler__SWIG_3_gen_be9d2f14c67e6fa7(XsVector *_swig_go_0) {
-  XsVector *arg1 = 0 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+XsString *_wrap_XsControl_resultText_gen_be9d2f14c67e6fa7(XsResultValue *_swig_go_0) {
+  XsResultValue arg1 ;
+  XsResultValue *argp1 ;
+  XsString result;
+  XsString *_swig_go_result;
   
-  arg1 = *(XsVector **)&_swig_go_0; 
   
-  result = (XsEuler *)new XsEuler((XsVector const &)*arg1);
-  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
+  argp1 = (XsResultValue *)_swig_go_0;
+  if (argp1 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsResultValue");
+  }
+  arg1 = (XsResultValue)*argp1;
+  
+  
+  result = XsControl::resultText(arg1);
+  *(XsString **)&_swig_go_result = new XsString(result); 
   return _swig_go_result;
 }
 
 
-XsEuler *_wrap_new_XSEuler__SWIG_4_gen_be9d2f14c67e6fa7(XsQuaternion *_swig_go_0) {
-  XsQuaternion *arg1 = 0 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+void _wrap_XsControl_clearHardwareError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
   
-  arg1 = *(XsQuaternion **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = (XsEuler *)new XsEuler((XsQuaternion const &)*arg1);
-  *(XsEuler **)&_swig_go_result = (XsEuler *)result; 
+  (arg1)->clearHardwareError();
+  
+}
+
+
+void _wrap_XsControl_close_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  
+  arg1 = *(XsControl **)&_swig_go_0; 
+  
+  (arg1)->close();
+  
+}
+
+
+bool _wrap_XsControl_openPort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, bool _swig_go_4) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsBaudRate arg3 ;
+  uint32_t arg4 ;
+  bool arg5 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  arg3 = (XsBaudRate)_swig_go_2; 
+  arg4 = (uint32_t)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
+  
+  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsEuler *_wrap_XSEuler_opAssign_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsEuler *arg2 = 0 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+bool _wrap_XsControl_openPort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsBaudRate arg3 ;
+  uint32_t arg4 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  arg3 = (XsBaudRate)_swig_go_2; 
+  arg4 = (uint32_t)_swig_go_3; 
   
-  result = (XsEuler *) &(arg1)->operator =((XsEuler const &)*arg2);
-  *(XsEuler **)&_swig_go_result = result; 
+  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3,arg4);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, long long _swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsSize arg2 ;
-  XsReal result;
-  double _swig_go_result;
+bool _wrap_XsControl_openPort__SWIG_2_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsBaudRate arg3 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  arg3 = (XsBaudRate)_swig_go_2; 
   
-  result = (XsReal)((XsEuler const *)arg1)->operator [](arg2);
+  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsReal *_wrap_XSEuler_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, long long _swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsSize arg2 ;
-  XsReal *result = 0 ;
-  XsReal *_swig_go_result;
+bool _wrap_XsControl_openPort__SWIG_3_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, intgo _swig_go_2, bool _swig_go_3) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  uint32_t arg3 ;
+  bool arg4 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = (uint32_t)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
   
-  result = (XsReal *) &(arg1)->operator [](arg2);
-  *(XsReal **)&_swig_go_result = result; 
+  result = (bool)(arg1)->openPort(*arg2,arg3,arg4);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSEuler_empty_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
+bool _wrap_XsControl_openPort__SWIG_4_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, intgo _swig_go_2) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  uint32_t arg3 ;
   bool result;
   bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = (uint32_t)_swig_go_2; 
   
-  result = (bool)((XsEuler const *)arg1)->empty();
+  result = (bool)(arg1)->openPort(*arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double *_wrap_XSEuler_data_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal *result = 0 ;
-  double *_swig_go_result;
+bool _wrap_XsControl_openPort__SWIG_5_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (XsReal *)((XsEuler const *)arg1)->data();
-  *(XsReal **)&_swig_go_result = (XsReal *)result; 
+  result = (bool)(arg1)->openPort(*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsEuler *_wrap_XSEuler_fromQuaternion_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsQuaternion *_swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsQuaternion *arg2 = 0 ;
-  XsEuler *result = 0 ;
-  XsEuler *_swig_go_result;
+bool _wrap_XsControl_openPortWithCredentials__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, XsString *_swig_go_2, XsString *_swig_go_3, intgo _swig_go_4) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  XsString *arg3 = 0 ;
+  XsString *arg4 = 0 ;
+  uint32_t arg5 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsQuaternion **)&_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = *(XsString **)&_swig_go_2; 
+  arg4 = *(XsString **)&_swig_go_3; 
+  arg5 = (uint32_t)_swig_go_4; 
   
-  result = (XsEuler *) &(arg1)->fromQuaternion((XsQuaternion const &)*arg2);
-  *(XsEuler **)&_swig_go_result = result; 
+  result = (bool)(arg1)->openPortWithCredentials(*arg2,(XsString const &)*arg3,(XsString const &)*arg4,arg5);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSEuler_opEq_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsEuler *arg2 = 0 ;
+bool _wrap_XsControl_openPortWithCredentials__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, XsString *_swig_go_2, XsString *_swig_go_3) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
+  XsString *arg3 = 0 ;
+  XsString *arg4 = 0 ;
   bool result;
   bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  arg3 = *(XsString **)&_swig_go_2; 
+  arg4 = *(XsString **)&_swig_go_3; 
   
-  result = (bool)((XsEuler const *)arg1)->operator ==((XsEuler const &)*arg2);
+  result = (bool)(arg1)->openPortWithCredentials(*arg2,(XsString const &)*arg3,(XsString const &)*arg4);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XSEuler_opNEq_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsEuler *arg2 = 0 ;
+bool _wrap_XsControl_openCustomPort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, bool _swig_go_3) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  int arg2 ;
+  uint32_t arg3 ;
+  bool arg4 ;
   bool result;
   bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (uint32_t)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
   
-  result = (bool)((XsEuler const *)arg1)->operator !=((XsEuler const &)*arg2);
+  result = (bool)(arg1)->openCustomPort(arg2,arg3,arg4);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_roll_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+bool _wrap_XsControl_openCustomPort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  int arg2 ;
+  uint32_t arg3 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (uint32_t)_swig_go_2; 
   
-  result = (XsReal)((XsEuler const *)arg1)->roll();
+  result = (bool)(arg1)->openCustomPort(arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_pitch_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+bool _wrap_XsControl_openImarPort_internal__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, intgo _swig_go_4) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsBaudRate arg3 ;
+  int arg4 ;
+  uint32_t arg5 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  arg3 = (XsBaudRate)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
+  arg5 = (uint32_t)_swig_go_4; 
   
-  result = (XsReal)((XsEuler const *)arg1)->pitch();
+  result = (bool)(arg1)->openImarPort_internal((XsString const &)*arg2,arg3,arg4,arg5);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_yaw_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+bool _wrap_XsControl_openImarPort_internal__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsBaudRate arg3 ;
+  int arg4 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  arg3 = (XsBaudRate)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
   
-  result = (XsReal)((XsEuler const *)arg1)->yaw();
+  result = (bool)(arg1)->openImarPort_internal((XsString const &)*arg2,arg3,arg4);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_x_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+void _wrap_XsControl_closePort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
+  
+  (arg1)->closePort((XsString const &)*arg2);
   
-  result = (XsReal)((XsEuler const *)arg1)->x();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_y_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+void _wrap_XsControl_closePort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsDeviceId *arg2 = 0 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
+  
+  (arg1)->closePort((XsDeviceId const &)*arg2);
   
-  result = (XsReal)((XsEuler const *)arg1)->y();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-double _wrap_XSEuler_z_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsReal result;
-  double _swig_go_result;
+void _wrap_XsControl_closePort__SWIG_2_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsPortInfo *arg2 = 0 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsPortInfo **)&_swig_go_1; 
+  
+  (arg1)->closePort((XsPortInfo const &)*arg2);
   
-  result = (XsReal)((XsEuler const *)arg1)->z();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XSEuler_isEqual_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0, XsEuler *_swig_go_1, double _swig_go_2) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
-  XsEuler *arg2 = 0 ;
-  XsReal arg3 ;
-  bool result;
-  bool _swig_go_result;
+void _wrap_XsControl_closeCustomPort_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  int arg2 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
-  arg2 = *(XsEuler **)&_swig_go_1; 
-  arg3 = (XsReal)_swig_go_2; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  (arg1)->closeCustomPort(arg2);
   
-  result = (bool)((XsEuler const *)arg1)->isEqual((XsEuler const &)*arg2,arg3);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_XSEuler_gen_be9d2f14c67e6fa7(XsEuler *_swig_go_0) {
-  XsEuler *arg1 = (XsEuler *) 0 ;
+void _wrap_XsControl_closePort__SWIG_3_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
   
-  arg1 = *(XsEuler **)&_swig_go_0; 
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  delete arg1;
+  (arg1)->closePort(arg2);
   
 }
 
 
-XsControl *_wrap_new_XsControl_gen_be9d2f14c67e6fa7() {
-  XsControl *result = 0 ;
-  XsControl *_swig_go_result;
+XsPortInfo *_wrap_XsControl_customPortInfo_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  int arg2 ;
+  XsPortInfo result;
+  XsPortInfo *_swig_go_result;
   
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  result = (XsControl *)new XsControl();
-  *(XsControl **)&_swig_go_result = (XsControl *)result; 
+  result = ((XsControl const *)arg1)->customPortInfo(arg2);
+  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_XsControl_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+bool _wrap_XsControl_openLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
   
-  delete arg1;
-  
+  result = (bool)(arg1)->openLogFile((XsString const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_flushInputBuffers_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+XsResultValue *_wrap_XsControl_lastResult_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsResultValue result;
+  XsResultValue *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  (arg1)->flushInputBuffers();
-  
+  result = ((XsControl const *)arg1)->lastResult();
+  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
+  return _swig_go_result;
 }
 
 
-XsString *_wrap_XsControl_resultText_gen_be9d2f14c67e6fa7(XsResultValue *_swig_go_0) {
-  XsResultValue arg1 ;
-  XsResultValue *argp1 ;
+XsString *_wrap_XsControl_lastResultText_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
   XsString result;
   XsString *_swig_go_result;
   
+  arg1 = *(XsControl **)&_swig_go_0; 
   
-  argp1 = (XsResultValue *)_swig_go_0;
-  if (argp1 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsResultValue");
-  }
-  arg1 = (XsResultValue)*argp1;
-  
-  
-  result = XsControl::resultText(arg1);
+  result = ((XsControl const *)arg1)->lastResultText();
   *(XsString **)&_swig_go_result = new XsString(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsControl_clearHardwareError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+XsResultValue *_wrap_XsControl_lastHardwareError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsResultValue result;
+  XsResultValue *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  (arg1)->clearHardwareError();
-  
+  result = ((XsControl const *)arg1)->lastHardwareError();
+  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_close_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+XsDeviceId *_wrap_XsControl_lastHardwareErrorDeviceId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsDeviceId result;
+  XsDeviceId *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  (arg1)->close();
-  
+  result = ((XsControl const *)arg1)->lastHardwareErrorDeviceId();
+  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
+  return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, bool _swig_go_4) {
+intgo _wrap_XsControl_deviceCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  XsBaudRate arg3 ;
-  uint32_t arg4 ;
-  bool arg5 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  arg3 = (XsBaudRate)_swig_go_2; 
-  arg4 = (uint32_t)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
   
-  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3,arg4,arg5);
+  result = (int)((XsControl const *)arg1)->deviceCount();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+intgo _wrap_XsControl_mainDeviceCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  XsBaudRate arg3 ;
-  uint32_t arg4 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  arg3 = (XsBaudRate)_swig_go_2; 
-  arg4 = (uint32_t)_swig_go_3; 
   
-  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3,arg4);
+  result = (int)((XsControl const *)arg1)->mainDeviceCount();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_2_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2) {
+std::vector< XsDeviceId > *_wrap_XsControl_mainDeviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  XsBaudRate arg3 ;
-  bool result;
-  bool _swig_go_result;
+  SwigValueWrapper< std::vector< XsDeviceId > > result;
+  std::vector< XsDeviceId > *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  arg3 = (XsBaudRate)_swig_go_2; 
   
-  result = (bool)(arg1)->openPort((XsString const &)*arg2,arg3);
-  _swig_go_result = result; 
+  result = ((XsControl const *)arg1)->mainDeviceIds();
+  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_3_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, intgo _swig_go_2, bool _swig_go_3) {
+intgo _wrap_XsControl_mtCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  uint32_t arg3 ;
-  bool arg4 ;
-  bool result;
-  bool _swig_go_result;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = (uint32_t)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
   
-  result = (bool)(arg1)->openPort(*arg2,arg3,arg4);
+  result = (int)((XsControl const *)arg1)->mtCount();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_4_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, intgo _swig_go_2) {
+std::vector< XsDeviceId > *_wrap_XsControl_mtDeviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  uint32_t arg3 ;
-  bool result;
-  bool _swig_go_result;
+  SwigValueWrapper< std::vector< XsDeviceId > > result;
+  std::vector< XsDeviceId > *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = (uint32_t)_swig_go_2; 
   
-  result = (bool)(arg1)->openPort(*arg2,arg3);
-  _swig_go_result = result; 
+  result = ((XsControl const *)arg1)->mtDeviceIds();
+  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPort__SWIG_5_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1) {
+std::vector< XsDeviceId > *_wrap_XsControl_deviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  SwigValueWrapper< std::vector< XsDeviceId > > result;
+  std::vector< XsDeviceId > *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  result = (bool)(arg1)->openPort(*arg2);
-  _swig_go_result = result; 
+  result = ((XsControl const *)arg1)->deviceIds();
+  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPortWithCredentials__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, XsString *_swig_go_2, XsString *_swig_go_3, intgo _swig_go_4) {
+XsDevice *_wrap_XsControl_getDeviceFromLocationId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, short _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  XsString *arg3 = 0 ;
-  XsString *arg4 = 0 ;
-  uint32_t arg5 ;
-  bool result;
-  bool _swig_go_result;
+  uint16_t arg2 ;
+  XsDevice *result = 0 ;
+  XsDevice *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = *(XsString **)&_swig_go_2; 
-  arg4 = *(XsString **)&_swig_go_3; 
-  arg5 = (uint32_t)_swig_go_4; 
+  arg2 = (uint16_t)_swig_go_1; 
   
-  result = (bool)(arg1)->openPortWithCredentials(*arg2,(XsString const &)*arg3,(XsString const &)*arg4,arg5);
-  _swig_go_result = result; 
+  result = (XsDevice *)((XsControl const *)arg1)->getDeviceFromLocationId(arg2);
+  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openPortWithCredentials__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1, XsString *_swig_go_2, XsString *_swig_go_3) {
+XsDeviceId *_wrap_XsControl_dockDeviceId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
-  XsString *arg3 = 0 ;
-  XsString *arg4 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDeviceId *arg2 = 0 ;
+  XsDeviceId result;
+  XsDeviceId *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
-  arg3 = *(XsString **)&_swig_go_2; 
-  arg4 = *(XsString **)&_swig_go_3; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  result = (bool)(arg1)->openPortWithCredentials(*arg2,(XsString const &)*arg3,(XsString const &)*arg4);
-  _swig_go_result = result; 
+  result = ((XsControl const *)arg1)->dockDeviceId((XsDeviceId const &)*arg2);
+  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openCustomPort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, bool _swig_go_3) {
+bool _wrap_XsControl_isDeviceWireless_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
-  uint32_t arg3 ;
-  bool arg4 ;
+  XsDeviceId *arg2 = 0 ;
   bool result;
   bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
-  arg3 = (uint32_t)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  result = (bool)(arg1)->openCustomPort(arg2,arg3,arg4);
+  result = (bool)((XsControl const *)arg1)->isDeviceWireless((XsDeviceId const &)*arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openCustomPort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2) {
+bool _wrap_XsControl_isDeviceDocked_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
-  uint32_t arg3 ;
+  XsDeviceId *arg2 = 0 ;
   bool result;
   bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
-  arg3 = (uint32_t)_swig_go_2; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  result = (bool)(arg1)->openCustomPort(arg2,arg3);
+  result = (bool)((XsControl const *)arg1)->isDeviceDocked((XsDeviceId const &)*arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openImarPort_internal__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, intgo _swig_go_4) {
+bool _wrap_XsControl_loadFilterProfiles_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
   XsString *arg2 = 0 ;
-  XsBaudRate arg3 ;
-  int arg4 ;
-  uint32_t arg5 ;
   bool result;
   bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   arg2 = *(XsString **)&_swig_go_1; 
-  arg3 = (XsBaudRate)_swig_go_2; 
-  arg4 = (int)_swig_go_3; 
-  arg5 = (uint32_t)_swig_go_4; 
   
-  result = (bool)(arg1)->openImarPort_internal((XsString const &)*arg2,arg3,arg4,arg5);
+  result = (bool)(arg1)->loadFilterProfiles((XsString const &)*arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openImarPort_internal__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+XsOption *_wrap_XsControl_enabledOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  XsBaudRate arg3 ;
-  int arg4 ;
-  bool result;
-  bool _swig_go_result;
+  XsOption result;
+  XsOption *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  arg3 = (XsBaudRate)_swig_go_2; 
-  arg4 = (int)_swig_go_3; 
   
-  result = (bool)(arg1)->openImarPort_internal((XsString const &)*arg2,arg3,arg4);
-  _swig_go_result = result; 
+  result = ((XsControl const *)arg1)->enabledOptions();
+  *(XsOption **)&_swig_go_result = new XsOption(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsControl_closePort__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+XsOption *_wrap_XsControl_disabledOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
+  XsOption result;
+  XsOption *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  
-  (arg1)->closePort((XsString const &)*arg2);
   
+  result = ((XsControl const *)arg1)->disabledOptions();
+  *(XsOption **)&_swig_go_result = new XsOption(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_closePort__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
+void _wrap_XsControl_setOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsOption *_swig_go_1, XsOption *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId *arg2 = 0 ;
+  XsOption arg2 ;
+  XsOption arg3 ;
+  XsOption *argp2 ;
+  XsOption *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  (arg1)->closePort((XsDeviceId const &)*arg2);
+  argp2 = (XsOption *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsOption");
+  }
+  arg2 = (XsOption)*argp2;
+  
+  
+  argp3 = (XsOption *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsOption");
+  }
+  arg3 = (XsOption)*argp3;
+  
+  
+  (arg1)->setOptions(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_closePort__SWIG_2_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsPortInfo *_swig_go_1) {
+void _wrap_XsControl_setOptionsForce_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsOption *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsPortInfo *arg2 = 0 ;
+  XsOption arg2 ;
+  XsOption *argp2 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsPortInfo **)&_swig_go_1; 
   
-  (arg1)->closePort((XsPortInfo const &)*arg2);
+  argp2 = (XsOption *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsOption");
+  }
+  arg2 = (XsOption)*argp2;
+  
+  
+  (arg1)->setOptionsForce(arg2);
   
 }
 
 
-void _wrap_XsControl_closeCustomPort_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1) {
+bool _wrap_XsControl_setInitialPositionLLA_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsVector *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
+  XsVector *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
-  
-  (arg1)->closeCustomPort(arg2);
+  arg2 = *(XsVector **)&_swig_go_1; 
   
+  result = (bool)(arg1)->setInitialPositionLLA((XsVector const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_closePort__SWIG_3_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1) {
+XsDevice *_wrap_XsControl_device_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDeviceId *arg2 = 0 ;
+  XsDevice *result = 0 ;
+  XsDevice *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  (arg1)->closePort(arg2);
+  result = (XsDevice *)((XsControl const *)arg1)->device((XsDeviceId const &)*arg2);
+  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
+  return _swig_go_result;
+}
+
+
+XsDevicePtrArray *_wrap_XsControl_mainDevices_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsDevicePtrArray result;
+  XsDevicePtrArray *_swig_go_result;
+  
+  arg1 = *(XsControl **)&_swig_go_0; 
   
+  result = ((XsControl const *)arg1)->mainDevices();
+  *(XsDevicePtrArray **)&_swig_go_result = new XsDevicePtrArray(result); 
+  return _swig_go_result;
 }
 
 
-XsPortInfo *_wrap_XsControl_customPortInfo_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1) {
+XsDevice *_wrap_XsControl_broadcast_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
-  XsPortInfo result;
-  XsPortInfo *_swig_go_result;
+  XsDevice *result = 0 ;
+  XsDevice *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
   
-  result = ((XsControl const *)arg1)->customPortInfo(arg2);
-  *(XsPortInfo **)&_swig_go_result = new XsPortInfo(result); 
+  result = (XsDevice *)((XsControl const *)arg1)->broadcast();
+  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_openLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+void _wrap_XsControl_transmissionReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, XsByteArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  int arg2 ;
+  XsByteArray *arg3 = 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = *(XsByteArray **)&_swig_go_2; 
+  
+  (arg1)->transmissionReceived(arg2,(XsByteArray const &)*arg3);
   
-  result = (bool)(arg1)->openLogFile((XsString const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsResultValue *_wrap_XsControl_lastResult_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  XsResultValue result;
-  XsResultValue *_swig_go_result;
+XsControl *_wrap_XsControl_construct_gen_be9d2f14c67e6fa7() {
+  XsControl *result = 0 ;
+  XsControl *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = ((XsControl const *)arg1)->lastResult();
-  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
+  result = (XsControl *)XsControl::construct();
+  *(XsControl **)&_swig_go_result = (XsControl *)result; 
   return _swig_go_result;
 }
 
 
-XsString *_wrap_XsControl_lastResultText_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_destruct_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString result;
-  XsString *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = ((XsControl const *)arg1)->lastResultText();
-  *(XsString **)&_swig_go_result = new XsString(result); 
-  return _swig_go_result;
+  (arg1)->destruct();
+  
 }
 
 
-XsResultValue *_wrap_XsControl_lastHardwareError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+bool _wrap_XsControl_finalizeOpenPort_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, Communicator *_swig_go_1, XsPortInfo *_swig_go_2, intgo _swig_go_3, bool _swig_go_4) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsResultValue result;
-  XsResultValue *_swig_go_result;
+  Communicator *arg2 = (Communicator *) 0 ;
+  XsPortInfo *arg3 = 0 ;
+  uint32_t arg4 ;
+  bool arg5 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(Communicator **)&_swig_go_1; 
+  arg3 = *(XsPortInfo **)&_swig_go_2; 
+  arg4 = (uint32_t)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
   
-  result = ((XsControl const *)arg1)->lastHardwareError();
-  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
+  result = (bool)(arg1)->finalizeOpenPort(arg2,*arg3,arg4,arg5);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsDeviceId *_wrap_XsControl_lastHardwareErrorDeviceId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_gotoConfig_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId result;
-  XsDeviceId *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = ((XsControl const *)arg1)->lastHardwareErrorDeviceId();
-  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
-  return _swig_go_result;
+  (arg1)->gotoConfig();
+  
 }
 
 
-intgo _wrap_XsControl_deviceCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_gotoMeasurement_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int result;
-  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = (int)((XsControl const *)arg1)->deviceCount();
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (arg1)->gotoMeasurement();
+  
 }
 
 
-intgo _wrap_XsControl_mainDeviceCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+XsResultValue *_wrap_XsControl_startRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  XsString *arg2 = 0 ;
+  XsResultValue result;
+  XsResultValue *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
   
-  result = (int)((XsControl const *)arg1)->mainDeviceCount();
-  _swig_go_result = result; 
+  result = (arg1)->startRestoreCommunication((XsString const &)*arg2);
+  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
   return _swig_go_result;
 }
 
 
-std::vector< XsDeviceId > *_wrap_XsControl_mainDeviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_stopRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  SwigValueWrapper< std::vector< XsDeviceId > > result;
-  std::vector< XsDeviceId > *_swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
-  result = ((XsControl const *)arg1)->mainDeviceIds();
-  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
-  return _swig_go_result;
+  (arg1)->stopRestoreCommunication();
+  
 }
 
 
-intgo _wrap_XsControl_mtCount_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDeviceState *_swig_go_2, XsDeviceState *_swig_go_3) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDeviceState arg3 ;
+  XsDeviceState arg4 ;
+  XsDeviceState *argp3 ;
+  XsDeviceState *argp4 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  
+  argp3 = (XsDeviceState *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsDeviceState");
+  }
+  arg3 = (XsDeviceState)*argp3;
+  
+  
+  argp4 = (XsDeviceState *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsDeviceState");
+  }
+  arg4 = (XsDeviceState)*argp4;
+  
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onDeviceStateChanged(arg2,arg3,arg4);
   
-  result = (int)((XsControl const *)arg1)->mtCount();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-std::vector< XsDeviceId > *_wrap_XsControl_mtDeviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  SwigValueWrapper< std::vector< XsDeviceId > > result;
-  std::vector< XsDeviceId > *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsDataPacket **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onLiveDataAvailable(arg2,(XsDataPacket const *)arg3);
   
-  result = ((XsControl const *)arg1)->mtDeviceIds();
-  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
-  return _swig_go_result;
 }
 
 
-std::vector< XsDeviceId > *_wrap_XsControl_deviceIds_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  SwigValueWrapper< std::vector< XsDeviceId > > result;
-  std::vector< XsDeviceId > *_swig_go_result;
+  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
+  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
+  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onAllLiveDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
   
-  result = ((XsControl const *)arg1)->deviceIds();
-  *(std::vector< XsDeviceId > **)&_swig_go_result = new std::vector< XsDeviceId >(result); 
-  return _swig_go_result;
 }
 
 
-XsDevice *_wrap_XsControl_getDeviceFromLocationId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, short _swig_go_1) {
+void _wrap_XsControl_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, intgo _swig_go_4) {
   XsControl *arg1 = (XsControl *) 0 ;
-  uint16_t arg2 ;
-  XsDevice *result = 0 ;
-  XsDevice *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  int arg3 ;
+  int arg4 ;
+  int arg5 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (uint16_t)_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
+  arg5 = (int)_swig_go_4; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onMissedPackets(arg2,arg3,arg4,arg5);
   
-  result = (XsDevice *)((XsControl const *)arg1)->getDeviceFromLocationId(arg2);
-  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
-  return _swig_go_result;
 }
 
 
-XsDeviceId *_wrap_XsControl_dockDeviceId_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
+void _wrap_XsControl_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, long long _swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId *arg2 = 0 ;
-  XsDeviceId result;
-  XsDeviceId *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  int64_t arg3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = (int64_t)_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onDataUnavailable(arg2,arg3);
   
-  result = ((XsControl const *)arg1)->dockDeviceId((XsDeviceId const &)*arg2);
-  *(XsDeviceId **)&_swig_go_result = new XsDeviceId(result); 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_isDeviceWireless_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
+void _wrap_XsControl_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onWakeupReceived(arg2);
   
-  result = (bool)((XsControl const *)arg1)->isDeviceWireless((XsDeviceId const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_isDeviceDocked_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
+void _wrap_XsControl_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, XsString *_swig_go_4) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  int arg3 ;
+  int arg4 ;
+  XsString *arg5 = (XsString *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
+  arg5 = *(XsString **)&_swig_go_4; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onProgressUpdated(arg2,arg3,arg4,(XsString const *)arg5);
   
-  result = (bool)((XsControl const *)arg1)->isDeviceDocked((XsDeviceId const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XsControl_loadFilterProfiles_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+intgo _wrap_XsControl_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsMessage *arg3 = (XsMessage *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsMessage **)&_swig_go_2; 
   
-  result = (bool)(arg1)->loadFilterProfiles((XsString const &)*arg2);
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  result = (int)(swig_b0)->onWriteMessageToLogFile(arg2,(XsMessage const *)arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOption *_wrap_XsControl_enabledOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsOption result;
-  XsOption *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsDataPacket **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onBufferedDataAvailable(arg2,(XsDataPacket const *)arg3);
   
-  result = ((XsControl const *)arg1)->enabledOptions();
-  *(XsOption **)&_swig_go_result = new XsOption(result); 
-  return _swig_go_result;
 }
 
 
-XsOption *_wrap_XsControl_disabledOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsOption result;
-  XsOption *_swig_go_result;
+  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
+  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
+  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onAllBufferedDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
   
-  result = ((XsControl const *)arg1)->disabledOptions();
-  *(XsOption **)&_swig_go_result = new XsOption(result); 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_setOptions_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsOption *_swig_go_1, XsOption *_swig_go_2) {
+void _wrap_XsControl_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsConnectivityState *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsOption arg2 ;
-  XsOption arg3 ;
-  XsOption *argp2 ;
-  XsOption *argp3 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsConnectivityState arg3 ;
+  XsConnectivityState *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  argp2 = (XsOption *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsOption");
-  }
-  arg2 = (XsOption)*argp2;
-  
-  
-  argp3 = (XsOption *)_swig_go_2;
+  argp3 = (XsConnectivityState *)_swig_go_2;
   if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsOption");
+    _swig_gopanic("Attempt to dereference null XsConnectivityState");
   }
-  arg3 = (XsOption)*argp3;
+  arg3 = (XsConnectivityState)*argp3;
   
   
-  (arg1)->setOptions(arg2,arg3);
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onConnectivityChanged(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_setOptionsForce_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsOption *_swig_go_1) {
+void _wrap_XsControl_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsInfoRequest *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsOption arg2 ;
-  XsOption *argp2 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsInfoRequest arg3 ;
+  XsInfoRequest *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  argp2 = (XsOption *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsOption");
+  argp3 = (XsInfoRequest *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsInfoRequest");
   }
-  arg2 = (XsOption)*argp2;
+  arg3 = (XsInfoRequest)*argp3;
   
   
-  (arg1)->setOptionsForce(arg2);
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onInfoResponse(arg2,arg3);
   
 }
 
 
-bool _wrap_XsControl_setInitialPositionLLA_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsVector *_swig_go_1) {
+void _wrap_XsControl_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsResultValue *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsVector *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsResultValue arg3 ;
+  XsResultValue *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsVector **)&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  result = (bool)(arg1)->setInitialPositionLLA((XsVector const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-XsDevice *_wrap_XsControl_device_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDeviceId *_swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  XsDeviceId *arg2 = 0 ;
-  XsDevice *result = 0 ;
-  XsDevice *_swig_go_result;
+  argp3 = (XsResultValue *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsResultValue");
+  }
+  arg3 = (XsResultValue)*argp3;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDeviceId **)&_swig_go_1; 
   
-  result = (XsDevice *)((XsControl const *)arg1)->device((XsDeviceId const &)*arg2);
-  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
-  return _swig_go_result;
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onError(arg2,arg3);
+  
 }
 
 
-XsDevicePtrArray *_wrap_XsControl_mainDevices_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevicePtrArray result;
-  XsDevicePtrArray *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsMessage *arg3 = (XsMessage *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsMessage **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onNonDataMessage(arg2,(XsMessage const *)arg3);
   
-  result = ((XsControl const *)arg1)->mainDevices();
-  *(XsDevicePtrArray **)&_swig_go_result = new XsDevicePtrArray(result); 
-  return _swig_go_result;
 }
 
 
-XsDevice *_wrap_XsControl_broadcast_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsProtocolType *_swig_go_2, XsByteArray *_swig_go_3) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *result = 0 ;
-  XsDevice *_swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsProtocolType arg3 ;
+  XsByteArray *arg4 = (XsByteArray *) 0 ;
+  XsProtocolType *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  result = (XsDevice *)((XsControl const *)arg1)->broadcast();
-  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
-  return _swig_go_result;
-}
-
-
-void _wrap_XsControl_transmissionReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, XsByteArray *_swig_go_2) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
-  XsByteArray *arg3 = 0 ;
+  argp3 = (XsProtocolType *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsProtocolType");
+  }
+  arg3 = (XsProtocolType)*argp3;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
-  arg3 = *(XsByteArray **)&_swig_go_2; 
+  arg4 = *(XsByteArray **)&_swig_go_3; 
   
-  (arg1)->transmissionReceived(arg2,(XsByteArray const &)*arg3);
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onMessageDetected(arg2,arg3,(XsByteArray const *)arg4);
   
 }
 
 
-XsControl *_wrap_XsControl_construct_gen_be9d2f14c67e6fa7() {
-  XsControl *result = 0 ;
-  XsControl *_swig_go_result;
+void _wrap_XsControl_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsMessage *arg3 = (XsMessage *) 0 ;
   
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsMessage **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onMessageReceivedFromDevice(arg2,(XsMessage const *)arg3);
   
-  result = (XsControl *)XsControl::construct();
-  *(XsControl **)&_swig_go_result = (XsControl *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_destruct_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsMessage *arg3 = (XsMessage *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsMessage **)&_swig_go_2; 
   
-  (arg1)->destruct();
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onMessageSentToDevice(arg2,(XsMessage const *)arg3);
   
 }
 
 
-bool _wrap_XsControl_finalizeOpenPort_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, Communicator *_swig_go_1, XsPortInfo *_swig_go_2, intgo _swig_go_3, bool _swig_go_4) {
+void _wrap_XsControl_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  Communicator *arg2 = (Communicator *) 0 ;
-  XsPortInfo *arg3 = 0 ;
-  uint32_t arg4 ;
-  bool arg5 ;
-  bool result;
-  bool _swig_go_result;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(Communicator **)&_swig_go_1; 
-  arg3 = *(XsPortInfo **)&_swig_go_2; 
-  arg4 = (uint32_t)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsDataPacket **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onDataAvailable(arg2,(XsDataPacket const *)arg3);
   
-  result = (bool)(arg1)->finalizeOpenPort(arg2,*arg3,arg4,arg5);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_gotoConfig_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
+  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
+  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
   
-  (arg1)->gotoConfig();
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onAllDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
   
 }
 
 
-void _wrap_XsControl_gotoMeasurement_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg3 = *(XsDataPacket **)&_swig_go_2; 
   
-  (arg1)->gotoMeasurement();
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onRecordedDataAvailable(arg2,(XsDataPacket const *)arg3);
   
 }
 
 
-XsResultValue *_wrap_XsControl_startRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+void _wrap_XsControl_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = 0 ;
-  XsResultValue result;
-  XsResultValue *_swig_go_result;
+  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
+  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
+  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
+  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onAllRecordedDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
   
-  result = (arg1)->startRestoreCommunication((XsString const &)*arg2);
-  *(XsResultValue **)&_swig_go_result = new XsResultValue(result); 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_stopRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_XsControl_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, XsByteArray *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
+  int arg2 ;
+  XsByteArray *arg3 = (XsByteArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = *(XsByteArray **)&_swig_go_2; 
   
-  (arg1)->stopRestoreCommunication();
+  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
+  (swig_b0)->onTransmissionRequest(arg2,(XsByteArray const *)arg3);
   
 }
 
 
-void _wrap_XsControl_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDeviceState *_swig_go_2, XsDeviceState *_swig_go_3) {
+void _wrap_XsControl_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, XsResultValue *_swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDeviceState arg3 ;
-  XsDeviceState arg4 ;
-  XsDeviceState *argp3 ;
-  XsDeviceState *argp4 ;
+  XsString *arg2 = (XsString *) 0 ;
+  XsResultValue arg3 ;
+  XsResultValue *argp3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg2 = *(XsString **)&_swig_go_1; 
   
-  argp3 = (XsDeviceState *)_swig_go_2;
+  argp3 = (XsResultValue *)_swig_go_2;
   if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsDeviceState");
-  }
-  arg3 = (XsDeviceState)*argp3;
-  
-  
-  argp4 = (XsDeviceState *)_swig_go_3;
-  if (argp4 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsDeviceState");
+    _swig_gopanic("Attempt to dereference null XsResultValue");
   }
-  arg4 = (XsDeviceState)*argp4;
+  arg3 = (XsResultValue)*argp3;
   
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onDeviceStateChanged(arg2,arg3,arg4);
+  (swig_b0)->onRestoreCommunication((XsString const *)arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
+void _wrap_XsControl_clearCallbackHandlers__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, bool _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
+  bool arg2 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsDataPacket **)&_swig_go_2; 
+  arg2 = (bool)_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onLiveDataAvailable(arg2,(XsDataPacket const *)arg3);
+  (swig_b0)->clearCallbackHandlers(arg2);
   
 }
 
 
-void _wrap_XsControl_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
+void _wrap_XsControl_clearCallbackHandlers__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
-  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
-  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onAllLiveDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
+  (swig_b0)->clearCallbackHandlers();
   
 }
 
 
-void _wrap_XsControl_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, intgo _swig_go_4) {
+void _wrap_XsControl_addCallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1, bool _swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  int arg3 ;
-  int arg4 ;
-  int arg5 ;
+  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
+  bool arg3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = (int)_swig_go_2; 
-  arg4 = (int)_swig_go_3; 
-  arg5 = (int)_swig_go_4; 
+  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onMissedPackets(arg2,arg3,arg4,arg5);
+  (swig_b0)->addCallbackHandler(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, long long _swig_go_2) {
+void _wrap_XsControl_addCallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  int64_t arg3 ;
+  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = (int64_t)_swig_go_2; 
+  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onDataUnavailable(arg2,arg3);
+  (swig_b0)->addCallbackHandler(arg2);
   
 }
 
 
-void _wrap_XsControl_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1) {
+void _wrap_XsControl_removeCallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1, bool _swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
+  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
+  bool arg3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onWakeupReceived(arg2);
+  (swig_b0)->removeCallbackHandler(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3, XsString *_swig_go_4) {
+void _wrap_XsControl_removeCallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  int arg3 ;
-  int arg4 ;
-  XsString *arg5 = (XsString *) 0 ;
+  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = (int)_swig_go_2; 
-  arg4 = (int)_swig_go_3; 
-  arg5 = *(XsString **)&_swig_go_4; 
+  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onProgressUpdated(arg2,arg3,arg4,(XsString const *)arg5);
+  (swig_b0)->removeCallbackHandler(arg2);
   
 }
 
 
-intgo _wrap_XsControl_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
+void _wrap_XsControl_clearChainedManagers_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsMessage *arg3 = (XsMessage *) 0 ;
-  int result;
-  intgo _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsMessage **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  result = (int)(swig_b0)->onWriteMessageToLogFile(arg2,(XsMessage const *)arg3);
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->clearChainedManagers();
+  
 }
 
 
-void _wrap_XsControl_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
+void _wrap_XsControl_addChainedManager_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsDataPacket **)&_swig_go_2; 
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onBufferedDataAvailable(arg2,(XsDataPacket const *)arg3);
+  (swig_b0)->addChainedManager(arg2);
   
 }
 
 
-void _wrap_XsControl_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
+void _wrap_XsControl_removeChainedManager_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
-  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
-  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onAllBufferedDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
+  (swig_b0)->removeChainedManager(arg2);
   
 }
 
 
-void _wrap_XsControl_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsConnectivityState *_swig_go_2) {
+void _wrap_XsControl_copyCallbackHandlersTo__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1, bool _swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsConnectivityState arg3 ;
-  XsConnectivityState *argp3 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  bool arg3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  
-  argp3 = (XsConnectivityState *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsConnectivityState");
-  }
-  arg3 = (XsConnectivityState)*argp3;
-  
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onConnectivityChanged(arg2,arg3);
+  (swig_b0)->copyCallbackHandlersTo(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsInfoRequest *_swig_go_2) {
+void _wrap_XsControl_copyCallbackHandlersTo__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsInfoRequest arg3 ;
-  XsInfoRequest *argp3 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  
-  argp3 = (XsInfoRequest *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsInfoRequest");
-  }
-  arg3 = (XsInfoRequest)*argp3;
-  
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onInfoResponse(arg2,arg3);
+  (swig_b0)->copyCallbackHandlersTo(arg2);
   
 }
 
 
-void _wrap_XsControl_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsResultValue *_swig_go_2) {
+void _wrap_XsControl_copyCallbackHandlersFrom__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1, bool _swig_go_2) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsResultValue arg3 ;
-  XsResultValue *argp3 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  bool arg3 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  
-  argp3 = (XsResultValue *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsResultValue");
-  }
-  arg3 = (XsResultValue)*argp3;
-  
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onError(arg2,arg3);
+  (swig_b0)->copyCallbackHandlersFrom(arg2,arg3);
   
 }
 
 
-void _wrap_XsControl_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
+void _wrap_XsControl_copyCallbackHandlersFrom__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsMessage *arg3 = (XsMessage *) 0 ;
+  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsMessage **)&_swig_go_2; 
+  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onNonDataMessage(arg2,(XsMessage const *)arg3);
+  (swig_b0)->copyCallbackHandlersFrom(arg2);
   
 }
 
 
-void _wrap_XsControl_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsProtocolType *_swig_go_2, XsByteArray *_swig_go_3) {
+void _wrap_SetXsControl_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsProtocolType arg3 ;
-  XsByteArray *arg4 = (XsByteArray *) 0 ;
-  XsProtocolType *argp3 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  
-  argp3 = (XsProtocolType *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsProtocolType");
-  }
-  arg3 = (XsProtocolType)*argp3;
-  
-  arg4 = *(XsByteArray **)&_swig_go_3; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onMessageDetected(arg2,arg3,(XsByteArray const *)arg4);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onDeviceStateChanged = arg2;
   
 }
 
 
-void _wrap_XsControl_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
+void* _wrap_GetXsControl_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsMessage *arg3 = (XsMessage *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsMessage **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onMessageReceivedFromDevice(arg2,(XsMessage const *)arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b2)->m_onDeviceStateChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsMessage *_swig_go_2) {
+void _wrap_SetXsControl_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsMessage *arg3 = (XsMessage *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsMessage **)&_swig_go_2; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onMessageSentToDevice(arg2,(XsMessage const *)arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onLiveDataAvailable = arg2;
   
 }
 
 
-void _wrap_XsControl_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
+void* _wrap_GetXsControl_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsDataPacket **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onDataAvailable(arg2,(XsDataPacket const *)arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
+void _wrap_SetXsControl_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
-  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
-  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onAllDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onMissedPackets = arg2;
   
 }
 
 
-void _wrap_XsControl_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevice *_swig_go_1, XsDataPacket *_swig_go_2) {
+void* _wrap_GetXsControl_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
-  XsDataPacket *arg3 = (XsDataPacket *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
-  arg3 = *(XsDataPacket **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onRecordedDataAvailable(arg2,(XsDataPacket const *)arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b2)->m_onMissedPackets);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsDevicePtrArray *_swig_go_1, XsDataPacketPtrArray *_swig_go_2) {
+void _wrap_SetXsControl_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsDevicePtrArray *arg2 = (XsDevicePtrArray *) 0 ;
-  XsDataPacketPtrArray *arg3 = (XsDataPacketPtrArray *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsDevicePtrArray **)&_swig_go_1; 
-  arg3 = *(XsDataPacketPtrArray **)&_swig_go_2; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onAllRecordedDataAvailable(arg2,(XsDataPacketPtrArray const *)arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onWakeupReceived = arg2;
   
 }
 
 
-void _wrap_XsControl_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, intgo _swig_go_1, XsByteArray *_swig_go_2) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  int arg2 ;
-  XsByteArray *arg3 = (XsByteArray *) 0 ;
+void* _wrap_GetXsControl_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
-  arg3 = *(XsByteArray **)&_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onTransmissionRequest(arg2,(XsByteArray const *)arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b2)->m_onWakeupReceived);
+  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1, XsResultValue *_swig_go_2) {
+void _wrap_SetXsControl_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsString *arg2 = (XsString *) 0 ;
-  XsResultValue arg3 ;
-  XsResultValue *argp3 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsString **)&_swig_go_1; 
-  
-  argp3 = (XsResultValue *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsResultValue");
-  }
-  arg3 = (XsResultValue)*argp3;
-  
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->onRestoreCommunication((XsString const *)arg2,arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onProgressUpdated = arg2;
   
 }
 
 
-void _wrap_XsControl_clearCallbackHandlers__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, bool _swig_go_1) {
+void* _wrap_GetXsControl_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  bool arg2 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = (bool)_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->clearCallbackHandlers(arg2);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b2)->m_onProgressUpdated);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_clearCallbackHandlers__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void _wrap_SetXsControl_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
+  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->clearCallbackHandlers();
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onWriteMessageToLogFile = arg2;
   
 }
 
 
-void _wrap_XsControl_addCallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1, bool _swig_go_2) {
+void* _wrap_GetXsControl_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
-  bool arg3 ;
+  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->addCallbackHandler(arg2,arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onWriteMessageToLogFile);
+  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_addCallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1) {
+void _wrap_SetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->addCallbackHandler(arg2);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onBufferedDataAvailable = arg2;
   
 }
 
 
-void _wrap_XsControl_removeCallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1, bool _swig_go_2) {
+void* _wrap_GetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
-  bool arg3 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->removeCallbackHandler(arg2,arg3);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_removeCallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsCallbackPlainC *_swig_go_1) {
+void _wrap_SetXsControl_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  XsCallbackPlainC *arg2 = (XsCallbackPlainC *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(XsCallbackPlainC **)&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->removeCallbackHandler(arg2);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onConnectivityChanged = arg2;
   
 }
 
 
-void _wrap_XsControl_clearChainedManagers_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->clearChainedManagers();
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b2)->m_onConnectivityChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_addChainedManager_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
+void _wrap_SetXsControl_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->addChainedManager(arg2);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onInfoResponse = arg2;
   
 }
 
 
-void _wrap_XsControl_removeChainedManager_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
+void* _wrap_GetXsControl_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->removeChainedManager(arg2);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b2)->m_onInfoResponse);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_copyCallbackHandlersTo__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1, bool _swig_go_2) {
+void _wrap_SetXsControl_M_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
-  bool arg3 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->copyCallbackHandlersTo(arg2,arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onError = arg2;
   
 }
 
 
-void _wrap_XsControl_copyCallbackHandlersTo__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
+void* _wrap_GetXsControl_M_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->copyCallbackHandlersTo(arg2);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b2)->m_onError);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsControl_copyCallbackHandlersFrom__SWIG_0_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1, bool _swig_go_2) {
+void _wrap_SetXsControl_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
-  bool arg3 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->copyCallbackHandlersFrom(arg2,arg3);
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  if (swig_b2) (swig_b2)->m_onNonDataMessage = arg2;
   
 }
 
 
-void _wrap_XsControl_copyCallbackHandlersFrom__SWIG_1_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, CallbackManagerXda *_swig_go_1) {
+void* _wrap_GetXsControl_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  CallbackManagerXda *arg2 = (CallbackManagerXda *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(CallbackManagerXda **)&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  (swig_b0)->copyCallbackHandlersFrom(arg2);
-  
+  XsCallback *swig_b1 = (XsCallback *)swig_b0;
+  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onNonDataMessage);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onDeviceStateChanged = arg2;
+  if (swig_b2) (swig_b2)->m_onMessageDetected = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25163,30 +26139,30 @@ void* _wrap_GetXsControl_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(XsControl *
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b2)->m_onDeviceStateChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b2)->m_onMessageDetected);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onLiveDataAvailable = arg2;
+  if (swig_b2) (swig_b2)->m_onMessageReceivedFromDevice = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25194,30 +26170,30 @@ void* _wrap_GetXsControl_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onMessageReceivedFromDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onMissedPackets = arg2;
+  if (swig_b2) (swig_b2)->m_onMessageSentToDevice = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25225,30 +26201,30 @@ void* _wrap_GetXsControl_M_onMissedPackets_gen_be9d2f14c67e6fa7(XsControl *_swig
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b2)->m_onMissedPackets);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onMessageSentToDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onWakeupReceived = arg2;
+  if (swig_b2) (swig_b2)->m_onAllLiveDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25256,30 +26232,30 @@ void* _wrap_GetXsControl_M_onWakeupReceived_gen_be9d2f14c67e6fa7(XsControl *_swi
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b2)->m_onWakeupReceived);
-  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onProgressUpdated = arg2;
+  if (swig_b2) (swig_b2)->m_onAllBufferedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25287,30 +26263,30 @@ void* _wrap_GetXsControl_M_onProgressUpdated_gen_be9d2f14c67e6fa7(XsControl *_sw
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b2)->m_onProgressUpdated);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onWriteMessageToLogFile = arg2;
+  if (swig_b2) (swig_b2)->m_onDataUnavailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25318,13 +26294,13 @@ void* _wrap_GetXsControl_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(XsContro
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onWriteMessageToLogFile);
-  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b2)->m_onDataUnavailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
   void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
@@ -25334,12 +26310,12 @@ void _wrap_SetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onBufferedDataAvailable = arg2;
+  if (swig_b2) (swig_b2)->m_onDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
   void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
@@ -25349,30 +26325,30 @@ void* _wrap_GetXsControl_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsContro
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onBufferedDataAvailable);
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onDataAvailable);
   *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onConnectivityChanged = arg2;
+  if (swig_b2) (swig_b2)->m_onAllDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25380,30 +26356,30 @@ void* _wrap_GetXsControl_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(XsControl
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b2)->m_onConnectivityChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onInfoResponse = arg2;
+  if (swig_b2) (swig_b2)->m_onRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25411,30 +26387,30 @@ void* _wrap_GetXsControl_M_onInfoResponse_gen_be9d2f14c67e6fa7(XsControl *_swig_
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b2)->m_onInfoResponse);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onError = arg2;
+  if (swig_b2) (swig_b2)->m_onAllRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25442,30 +26418,30 @@ void* _wrap_GetXsControl_M_onError_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b2)->m_onError);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onNonDataMessage = arg2;
+  if (swig_b2) (swig_b2)->m_onTransmissionRequest = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25473,30 +26449,30 @@ void* _wrap_GetXsControl_M_onNonDataMessage_gen_be9d2f14c67e6fa7(XsControl *_swi
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onNonDataMessage);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b2)->m_onTransmissionRequest);
+  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetXsControl_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
   
   arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
   
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onMessageDetected = arg2;
+  if (swig_b2) (swig_b2)->m_onRestoreCommunication = arg2;
   
 }
 
 
-void* _wrap_GetXsControl_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
+void* _wrap_GetXsControl_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
   XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(XsControl **)&_swig_go_0; 
@@ -25504,2174 +26480,2355 @@ void* _wrap_GetXsControl_M_onMessageDetected_gen_be9d2f14c67e6fa7(XsControl *_sw
   CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
   XsCallback *swig_b1 = (XsCallback *)swig_b0;
   XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b2)->m_onMessageDetected);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b2)->m_onRestoreCommunication);
+  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsOutputConfiguration *_swig_go_1) {
+  XsSize arg1 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onMessageReceivedFromDevice = arg2;
+  arg1 = (size_t)_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1,(XsOutputConfiguration const *)arg2);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  XsSize arg1 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = (size_t)_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onMessageReceivedFromDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_2_gen_be9d2f14c67e6fa7() {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onMessageSentToDevice = arg2;
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg1 = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+  
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_0; 
   
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg1);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfiguration *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  XsOutputConfiguration *arg1 = (XsOutputConfiguration *) 0 ;
+  XsSize arg2 ;
+  XsDataFlags arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsOutputConfiguration **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (XsDataFlags)_swig_go_2; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b2)->m_onMessageSentToDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1,arg2,arg3);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+void _wrap_delete_XsArrayImplXsOutput_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onAllLiveDataAvailable = arg2;
+  delete arg1;
   
 }
 
 
-void* _wrap_GetXsControl_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_XsArrayImplXsOutput_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  (arg1)->clear();
+  
+}
+
+
+bool _wrap_XsArrayImplXsOutput_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator ==((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+bool _wrap_XsArrayImplXsOutput_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator !=((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+void _wrap_XsArrayImplXsOutput_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onAllBufferedDataAvailable = arg2;
+  (arg1)->reserve(arg2);
   
 }
 
 
-void* _wrap_GetXsControl_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+long long _wrap_XsArrayImplXsOutput_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize result;
+  long long _swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->reserved();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
+XsArrayDescriptor *_wrap_XsArrayImplXsOutput_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onDataUnavailable = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = (XsArrayDescriptor *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->descriptor();
+  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onDataUnavailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsOutput_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b2)->m_onDataUnavailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->begin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsOutput_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onDataAvailable = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->end();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsOutput_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->rbegin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsOutput_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onAllDataAvailable = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->rend();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (arg1)->begin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onRecordedDataAvailable = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = (arg1)->end();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b2)->m_onRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (arg1)->rbegin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onAllRecordedDataAvailable = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = (arg1)->rend();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b2)->m_onAllRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator [](arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onTransmissionRequest = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
+  result = (XsOutputConfiguration *) &(arg1)->operator [](arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
-  void* _swig_go_result;
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b2)->m_onTransmissionRequest);
-  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->value(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_SetXsControl_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, void* _swig_go_1) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
-  
-  arg1 = *(XsControl **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  if (swig_b2) (swig_b2)->m_onRestoreCommunication = arg2;
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->first();
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetXsControl_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0) {
-  XsControl *arg1 = (XsControl *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
-  void* _swig_go_result;
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsControl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  CallbackManagerXda *swig_b0 = (CallbackManagerXda *)arg1;
-  XsCallback *swig_b1 = (XsCallback *)swig_b0;
-  XsCallbackPlainC *swig_b2 = (XsCallbackPlainC *)swig_b1;
-  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b2)->m_onRestoreCommunication);
-  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->last();
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsSize arg1 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1,(XsOutputConfiguration const *)arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
+  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->at(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  XsSize arg1 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+XsOutputConfiguration *_wrap_XsArrayImplXsOutput_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
+  result = (XsOutputConfiguration *) &(arg1)->at(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_2_gen_be9d2f14c67e6fa7() {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+void _wrap_XsArrayImplXsOutput_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
+  XsSize arg3 ;
   
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg1 = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+void _wrap_XsArrayImplXsOutput_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  XsSize arg3 ;
+  XsSize arg4 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_0; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg1);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_new_XsArrayImplXsOutput__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfiguration *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  XsOutputConfiguration *arg1 = (XsOutputConfiguration *) 0 ;
-  XsSize arg2 ;
-  XsDataFlags arg3 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+void _wrap_XsArrayImplXsOutput_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
   
-  arg1 = *(XsOutputConfiguration **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (XsDataFlags)_swig_go_2; 
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  
+  
+  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >(arg1,arg2,arg3);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_XsArrayImplXsOutput_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
   
-  delete arg1;
+  
+  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_clear_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  (arg1)->clear();
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
 }
 
 
-bool _wrap_XsArrayImplXsOutput_opEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+void _wrap_XsArrayImplXsOutput_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
+  
+  arg4 = (size_t)_swig_go_3; 
+  
+  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator ==((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayImplXsOutput_opNEq_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+void _wrap_XsArrayImplXsOutput_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsOutputConfiguration *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  (arg1)->push_back((XsOutputConfiguration const &)*arg2);
   
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator !=((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_reserve_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsArrayImplXsOutput_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->reserve(arg2);
+  (arg1)->pop_back(arg2);
   
 }
 
 
-long long _wrap_XsArrayImplXsOutput_reserved_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->reserved();
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (arg1)->pop_back();
+  
 }
 
 
-XsArrayDescriptor *_wrap_XsArrayImplXsOutput_descriptor_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+  XsOutputConfiguration *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  (arg1)->push_front((XsOutputConfiguration const &)*arg2);
   
-  result = (XsArrayDescriptor *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->descriptor();
-  *(XsArrayDescriptor **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsOutput_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
+  XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->pop_front(arg2);
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->begin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsArrayImplXsOutput_end__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->end();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
-  return _swig_go_result;
+  (arg1)->pop_front();
+  
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsOutput_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+long long _wrap_XsArrayImplXsOutput_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
+  XsSize result;
+  long long _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->rbegin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->size();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsArrayImplXsOutput_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
+  XsSize arg2 ;
+  XsSize arg3 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  
+  (arg1)->erase(arg2,arg3);
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->rend();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
+  XsSize arg2 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  (arg1)->erase(arg2);
   
-  result = (arg1)->begin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_end__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > arg2 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = (arg1)->end();
+  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator");
+  }
+  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator)*argp2;
+  
+  
+  result = (arg1)->erase(arg2);
   *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > arg2 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *argp2 ;
   SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = (arg1)->rbegin();
+  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator");
+  }
+  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator)*argp2;
+  
+  
+  result = (arg1)->erase(arg2);
   *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+void _wrap_XsArrayImplXsOutput_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, XsOutputConfiguration *_swig_go_2) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
+  XsSize arg2 ;
+  XsOutputConfiguration *arg3 = (XsOutputConfiguration *) 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = *(XsOutputConfiguration **)&_swig_go_2; 
+  
+  (arg1)->assign(arg2,(XsOutputConfiguration const *)arg3);
   
-  result = (arg1)->rend();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsArrayImplXsOutput_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->operator [](arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (arg1)->resize(arg2);
+  
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsArrayImplXsOutput_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  result = (XsOutputConfiguration *) &(arg1)->operator [](arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (arg1)->setSize(arg2);
+  
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_value_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsArrayImplXsOutput_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  
+  (arg1)->append((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->value(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
-  return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_first_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_XsArrayImplXsOutput_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->first();
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_last_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+bool _wrap_XsArrayImplXsOutput_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->last();
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->empty();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_at__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->at(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  result = (XsOutputConfigurationArray *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->inherited();
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsArrayImplXsOutput_at__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
+XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsOutputConfiguration *) &(arg1)->at(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  result = (XsOutputConfigurationArray *) &(arg1)->inherited();
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2) {
+void _wrap_XsArrayImplXsOutput_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  XsSize arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
-  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
+  (arg1)->swap(*arg2);
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
+void _wrap_XsArrayImplXsOutput_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  XsSize arg2 ;
   XsSize arg3 ;
-  XsSize arg4 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg2 = (size_t)_swig_go_1; 
   arg3 = (size_t)_swig_go_2; 
-  arg4 = (size_t)_swig_go_3; 
   
-  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
+  (arg1)->swap(arg2,arg3);
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2) {
+XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   XsOutputConfiguration *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  result = (XsOutputConfigurationArray *) &(arg1)->operator <<((XsOutputConfiguration const &)*arg2);
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_XsArrayImplXsOutput_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
+  result = (int)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->find((XsOutputConfiguration const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_XsArrayImplXsOutput_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  
+  (arg1)->removeDuplicates();
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2) {
+void _wrap_XsArrayImplXsOutput_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, void* _swig_go_1) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
+  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
+  (arg1)->removeDuplicatesPredicate(arg2);
   
+}
+
+
+void _wrap_XsArrayImplXsOutput_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
   
-  (arg1)->insert((XsOutputConfiguration const &)*arg2,arg3);
+  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  
+  (arg1)->sort();
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
+void _wrap_XsArrayImplXsOutput_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
   
   arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  (arg1)->reverse();
   
-  arg4 = (size_t)_swig_go_3; 
+}
+
+
+XsArrayDescriptor *_wrap_g_xsOutputConfigurationArrayDescriptor_get_gen_be9d2f14c67e6fa7() {
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
+  
+  
+  result = (XsArrayDescriptor *)&g_xsOutputConfigurationArrayDescriptor;
+  *(XsArrayDescriptor **)&_swig_go_result = (XsArrayDescriptor *)result; 
+  return _swig_go_result;
+}
+
+
+XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsOutputConfiguration *_swig_go_1) {
+  XsSize arg1 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
+  
+  arg1 = (size_t)_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1,(XsOutputConfiguration const *)arg2);
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
+  return _swig_go_result;
+}
+
+
+XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  XsSize arg1 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
-  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
+  arg1 = (size_t)_swig_go_0; 
   
+  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1);
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
-  
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
-  
-  arg4 = (size_t)_swig_go_3; 
+XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_2_gen_be9d2f14c67e6fa7() {
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
-  (arg1)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
+  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray();
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_push_back_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = 0 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
-  (arg1)->push_back((XsOutputConfiguration const &)*arg2);
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
+  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray((XsOutputConfigurationArray const &)*arg1);
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfiguration *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  XsOutputConfiguration *arg1 = (XsOutputConfiguration *) 0 ;
   XsSize arg2 ;
+  XsDataFlags arg3 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfiguration **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
+  arg3 = (XsDataFlags)_swig_go_2; 
   
-  (arg1)->pop_back(arg2);
-  
+  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1,arg2,arg3);
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+void _wrap_delete_XsOutputConfigurationArray_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  (arg1)->pop_back();
+  delete arg1;
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_push_front_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
+void _wrap_XsOutputConfigurationArray_clear_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  (arg1)->push_front((XsOutputConfiguration const &)*arg2);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->clear();
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
+bool _wrap_XsOutputConfigurationArray_opEq_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
-  (arg1)->pop_front(arg2);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator ==((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+bool _wrap_XsOutputConfigurationArray_opNEq_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator !=((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+void _wrap_XsOutputConfigurationArray_reserve_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsSize arg2 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->pop_front();
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->reserve(arg2);
   
 }
 
 
-long long _wrap_XsArrayImplXsOutput_size_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+long long _wrap_XsOutputConfigurationArray_reserved_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize result;
   long long _swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->size();
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->reserved();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+XsArrayDescriptor *_wrap_XsOutputConfigurationArray_descriptor_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsArrayDescriptor *result = 0 ;
+  XsArrayDescriptor *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  (arg1)->erase(arg2,arg3);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (XsArrayDescriptor *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->descriptor();
+  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsOutputConfigurationArray_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->begin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  (arg1)->erase(arg2);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (swig_b0)->begin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsOutputConfigurationArray_end__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->end();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsArrayImplXsOutput_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > arg2 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *argp2 ;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_end__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator");
-  }
-  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator)*argp2;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (swig_b0)->end();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsOutputConfigurationArray_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
   
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  result = (arg1)->erase(arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->rbegin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsArrayImplXsOutput_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > arg2 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *argp2 ;
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator");
-  }
-  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator)*argp2;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (swig_b0)->rbegin();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsOutputConfigurationArray_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->rend();
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
+  return _swig_go_result;
+}
+
+
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  result = (arg1)->erase(arg2);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (swig_b0)->rend();
   *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_assign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, XsOutputConfiguration *_swig_go_2) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize arg2 ;
-  XsOutputConfiguration *arg3 = (XsOutputConfiguration *) 0 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
-  arg3 = *(XsOutputConfiguration **)&_swig_go_2; 
-  
-  (arg1)->assign(arg2,(XsOutputConfiguration const *)arg3);
   
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator [](arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_resize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->resize(arg2);
-  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (XsOutputConfiguration *) &(swig_b0)->operator [](arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_setSize_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_value_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize arg2 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
   
-  (arg1)->setSize(arg2);
-  
-}
-
-
-void _wrap_XsArrayImplXsOutput_append_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
-  
-  (arg1)->append((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  
-}
-
-
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_XsArrayImplXsOutput_opAssign_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
-  
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *) &(arg1)->operator =((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_result = result; 
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->value(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
   return _swig_go_result;
 }
 
 
-bool _wrap_XsArrayImplXsOutput_empty_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  bool result;
-  bool _swig_go_result;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_first_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->empty();
-  _swig_go_result = result; 
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->first();
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
   return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_last_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration result;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
-  result = (XsOutputConfigurationArray *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->inherited();
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->last();
+  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
   return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_at__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (XsOutputConfigurationArray *) &(arg1)->inherited();
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->at(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+XsOutputConfiguration *_wrap_XsOutputConfigurationArray_at__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsSize arg2 ;
+  XsOutputConfiguration *result = 0 ;
+  XsOutputConfiguration *_swig_go_result;
   
-  (arg1)->swap(*arg2);
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  result = (XsOutputConfiguration *) &(swig_b0)->at(arg2);
+  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsArrayImplXsOutput_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsSize arg2 ;
+void _wrap_XsOutputConfigurationArray_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
   XsSize arg3 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   arg3 = (size_t)_swig_go_2; 
   
-  (arg1)->swap(arg2,arg3);
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
 }
 
 
-XsOutputConfigurationArray *_wrap_XsArrayImplXsOutput_opLeftShift_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_XsOutputConfigurationArray_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  XsSize arg3 ;
+  XsSize arg4 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
+  arg4 = (size_t)_swig_go_3; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
-  result = (XsOutputConfigurationArray *) &(arg1)->operator <<((XsOutputConfiguration const &)*arg2);
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XsArrayImplXsOutput_find_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+void _wrap_XsOutputConfigurationArray_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsOutputConfiguration *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  result = (int)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)arg1)->find((XsOutputConfiguration const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-void _wrap_XsArrayImplXsOutput_removeDuplicates_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  (arg1)->removeDuplicates();
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0, void* _swig_go_1) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
-  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+void _wrap_XsOutputConfigurationArray_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
   
-  (arg1)->removeDuplicatesPredicate(arg2);
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-}
-
-
-void _wrap_XsArrayImplXsOutput_sort_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
   
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
   
-  (arg1)->sort();
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
 }
 
 
-void _wrap_XsArrayImplXsOutput_reverse_gen_be9d2f14c67e6fa7(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_0) {
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *arg1 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *) 0 ;
-  
-  arg1 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > **)&_swig_go_0; 
+void _wrap_XsOutputConfigurationArray_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
   
-  (arg1)->reverse();
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-}
-
-
-XsArrayDescriptor *_wrap_g_xsOutputConfigurationArrayDescriptor_get_gen_be9d2f14c67e6fa7() {
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
   
+  arg4 = (size_t)_swig_go_3; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
-  result = (XsArrayDescriptor *)&g_xsOutputConfigurationArrayDescriptor;
-  *(XsArrayDescriptor **)&_swig_go_result = (XsArrayDescriptor *)result; 
-  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsSize arg1 ;
+void _wrap_XsOutputConfigurationArray_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
+  XsSize arg4 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1,(XsOutputConfiguration const *)arg2);
-  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
-  return _swig_go_result;
-}
-
-
-XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_1_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  XsSize arg1 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
+  }
+  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg4 = (size_t)_swig_go_3; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
   
-  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1);
-  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
-  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_2_gen_be9d2f14c67e6fa7() {
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_XsOutputConfigurationArray_push_back_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
   
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->push_back((XsOutputConfiguration const &)*arg2);
   
-  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray();
-  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
-  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_XsOutputConfigurationArray_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->pop_back(arg2);
   
-  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray((XsOutputConfigurationArray const &)*arg1);
-  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
-  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_new_XsOutputConfigurationArray__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfiguration *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  XsOutputConfiguration *arg1 = (XsOutputConfiguration *) 0 ;
-  XsSize arg2 ;
-  XsDataFlags arg3 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_XsOutputConfigurationArray_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   
-  arg1 = *(XsOutputConfiguration **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (XsDataFlags)_swig_go_2; 
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->pop_back();
   
-  result = (XsOutputConfigurationArray *)new XsOutputConfigurationArray(arg1,arg2,arg3);
-  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_XsOutputConfigurationArray_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_push_front_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsOutputConfiguration *arg2 = 0 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
-  delete arg1;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
+  (swig_b0)->push_front((XsOutputConfiguration const &)*arg2);
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_clear_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  XsSize arg2 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->clear();
+  (swig_b0)->pop_front(arg2);
   
 }
 
 
-bool _wrap_XsOutputConfigurationArray_opEq_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+void _wrap_XsOutputConfigurationArray_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator ==((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->pop_front();
+  
 }
 
 
-bool _wrap_XsOutputConfigurationArray_opNEq_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
+long long _wrap_XsOutputConfigurationArray_size_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  bool result;
-  bool _swig_go_result;
+  XsSize result;
+  long long _swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator !=((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->size();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_reserve_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsOutputConfigurationArray_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize arg2 ;
+  XsSize arg3 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->reserve(arg2);
+  (swig_b0)->erase(arg2,arg3);
   
 }
 
 
-long long _wrap_XsOutputConfigurationArray_reserved_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+  XsSize arg2 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->reserved();
-  _swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->erase(arg2);
+  
 }
 
 
-XsArrayDescriptor *_wrap_XsOutputConfigurationArray_descriptor_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayDescriptor *result = 0 ;
-  XsArrayDescriptor *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > arg2 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
+  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator");
+  }
+  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator)*argp2;
+  
+  
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsArrayDescriptor *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->descriptor();
-  *(XsArrayDescriptor **)&_swig_go_result = result; 
+  result = (swig_b0)->erase(arg2);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsOutputConfigurationArray_begin__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > arg2 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *argp2 ;
+  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
+  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *)_swig_go_1;
+  if (argp2 == NULL) {
+    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator");
+  }
+  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator)*argp2;
+  
+  
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->begin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
+  result = (swig_b0)->erase(arg2);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_begin__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_assign_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, XsOutputConfiguration *_swig_go_2) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
+  XsSize arg2 ;
+  XsOutputConfiguration *arg3 = (XsOutputConfiguration *) 0 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
+  arg3 = *(XsOutputConfiguration **)&_swig_go_2; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->begin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
-  return _swig_go_result;
+  (swig_b0)->assign(arg2,(XsOutputConfiguration const *)arg3);
+  
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_wrap_XsOutputConfigurationArray_end__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_resize_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_result;
+  XsSize arg2 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->end();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator(result); 
-  return _swig_go_result;
+  (swig_b0)->resize(arg2);
+  
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_end__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_setSize_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
+  XsSize arg2 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->end();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
-  return _swig_go_result;
+  (swig_b0)->setSize(arg2);
+  
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsOutputConfigurationArray_rbegin__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+void _wrap_XsOutputConfigurationArray_append_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->rbegin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
-  return _swig_go_result;
+  (swig_b0)->append((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_rbegin__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_XsOutputConfigurationArray_opAssign_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *result = 0 ;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->rbegin();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
+  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *) &(swig_b0)->operator =((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_wrap_XsOutputConfigurationArray_rend__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+bool _wrap_XsOutputConfigurationArray_empty_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_result;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->rend();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator(result); 
+  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->empty();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_rend__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->rend();
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
+  result = (XsOutputConfigurationArray *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->inherited();
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_opIndex__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->operator [](arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
+  result = (XsOutputConfigurationArray *) &(swig_b0)->inherited();
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_opIndex__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsOutputConfigurationArray_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
+  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfiguration *) &(swig_b0)->operator [](arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->swap(*arg2);
+  
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_value_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsOutputConfigurationArray_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
   XsSize arg2 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  XsSize arg3 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
   arg2 = (size_t)_swig_go_1; 
+  arg3 = (size_t)_swig_go_2; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->value(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
-  return _swig_go_result;
+  (swig_b0)->swap(arg2,arg3);
+  
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_first_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_opLeftShift_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  XsOutputConfiguration *arg2 = 0 ;
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->first();
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
+  result = (XsOutputConfigurationArray *) &(swig_b0)->operator <<((XsOutputConfiguration const &)*arg2);
+  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_last_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+intgo _wrap_XsOutputConfigurationArray_find_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration result;
-  XsOutputConfiguration *_swig_go_result;
+  XsOutputConfiguration *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->last();
-  *(XsOutputConfiguration **)&_swig_go_result = new XsOutputConfiguration(result); 
+  result = (int)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->find((XsOutputConfiguration const &)*arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_at__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsOutputConfigurationArray_removeDuplicates_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfiguration *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->at(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->removeDuplicates();
+  
 }
 
 
-XsOutputConfiguration *_wrap_XsOutputConfigurationArray_at__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
+void _wrap_XsOutputConfigurationArray_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, void* _swig_go_1) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *result = 0 ;
-  XsOutputConfiguration *_swig_go_result;
+  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfiguration *) &(swig_b0)->at(arg2);
-  *(XsOutputConfiguration **)&_swig_go_result = result; 
-  return _swig_go_result;
+  (swig_b0)->removeDuplicatesPredicate(arg2);
+  
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2) {
+void _wrap_XsOutputConfigurationArray_sort_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  XsSize arg3 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
+  (swig_b0)->sort();
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, long long _swig_go_2, long long _swig_go_3) {
+void _wrap_XsOutputConfigurationArray_reverse_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
   XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  XsSize arg3 ;
-  XsSize arg4 ;
   
   arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
-  arg4 = (size_t)_swig_go_3; 
   
   XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
+  (swig_b0)->reverse();
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_2_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+CallbackHandler *_wrap_new_CallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
+  size_t arg1 ;
+  CallbackHandler *result = 0 ;
+  CallbackHandler *_swig_go_result;
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  arg1 = (size_t)_swig_go_0; 
   
+  result = (CallbackHandler *)new CallbackHandler(arg1);
+  *(CallbackHandler **)&_swig_go_result = (CallbackHandler *)result; 
+  return _swig_go_result;
+}
+
+
+CallbackHandler *_wrap_new_CallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7() {
+  CallbackHandler *result = 0 ;
+  CallbackHandler *_swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
   
+  result = (CallbackHandler *)new CallbackHandler();
+  *(CallbackHandler **)&_swig_go_result = (CallbackHandler *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
+void _wrap_delete_CallbackHandler_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
+  delete arg1;
   
+}
+
+
+bool _wrap_CallbackHandler_packetAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const &)*arg2,arg3);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  result = (bool)((CallbackHandler const *)arg1)->packetAvailable();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_4_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > *_swig_go_2, long long _swig_go_3) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< 1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *argp3 ;
+XsDataPacket *_wrap_CallbackHandler_getNextPacket_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  XsDataPacket result;
+  XsDataPacket *_swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_iterator)*argp3;
+  result = (arg1)->getNextPacket();
+  *(XsDataPacket **)&_swig_go_result = new XsDataPacket(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetCallbackHandler_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
   
-  arg4 = (size_t)_swig_go_3; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onDeviceStateChanged = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_insert__SWIG_5_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > *_swig_go_2, long long _swig_go_3) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = (XsOutputConfiguration *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImplConst< -1 > > arg3 ;
-  XsSize arg4 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *argp3 ;
+void* _wrap_GetCallbackHandler_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
+  void* _swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  argp3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator");
-  }
-  arg3 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::const_reverse_iterator)*argp3;
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b1)->m_onDeviceStateChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_SetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
-  arg4 = (size_t)_swig_go_3; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->insert((XsOutputConfiguration const *)arg2,arg3,arg4);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onLiveDataAvailable = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_push_back_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->push_back((XsOutputConfiguration const &)*arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_pop_back__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
+void _wrap_SetCallbackHandler_M_onMissedPackets_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->pop_back(arg2);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onMissedPackets = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_pop_back__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+void* _wrap_GetCallbackHandler_M_onMissedPackets_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->pop_back();
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b1)->m_onMissedPackets);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_push_front_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
+void _wrap_SetCallbackHandler_M_onWakeupReceived_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->push_front((XsOutputConfiguration const &)*arg2);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onWakeupReceived = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_pop_front__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onWakeupReceived_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->pop_front(arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b1)->m_onWakeupReceived);
+  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_pop_front__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+void _wrap_SetCallbackHandler_M_onProgressUpdated_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->pop_front();
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onProgressUpdated = arg2;
   
 }
 
 
-long long _wrap_XsOutputConfigurationArray_size_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize result;
-  long long _swig_go_result;
+void* _wrap_GetCallbackHandler_M_onProgressUpdated_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
+  void* _swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = ((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->size();
-  _swig_go_result = result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b1)->m_onProgressUpdated);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_erase__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
+void _wrap_SetCallbackHandler_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->erase(arg2,arg3);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onWriteMessageToLogFile = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_erase__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->erase(arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onWriteMessageToLogFile);
+  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_wrap_XsOutputConfigurationArray_erase__SWIG_2_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > arg2 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *argp2 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< 1 > *_swig_go_result;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+void _wrap_SetCallbackHandler_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
-  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator");
-  }
-  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator)*argp2;
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onBufferedDataAvailable = arg2;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->erase(arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::iterator(result); 
-  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_wrap_XsOutputConfigurationArray_erase__SWIG_3_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > arg2 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *argp2 ;
-  SwigValueWrapper< XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > > result;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::IteratorImpl< -1 > *_swig_go_result;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  
-  argp2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator *)_swig_go_1;
-  if (argp2 == NULL) {
-    _swig_gopanic("Attempt to dereference null XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator");
-  }
-  arg2 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator)*argp2;
+void* _wrap_GetCallbackHandler_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
   
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (swig_b0)->erase(arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator **)&_swig_go_result = new XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::reverse_iterator(result); 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_assign_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, XsOutputConfiguration *_swig_go_2) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsOutputConfiguration *arg3 = (XsOutputConfiguration *) 0 ;
+void _wrap_SetCallbackHandler_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = *(XsOutputConfiguration **)&_swig_go_2; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->assign(arg2,(XsOutputConfiguration const *)arg3);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onConnectivityChanged = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_resize_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->resize(arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b1)->m_onConnectivityChanged);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_setSize_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
+void _wrap_SetCallbackHandler_M_onInfoResponse_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->setSize(arg2);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onInfoResponse = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_append_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onInfoResponse_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->append((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b1)->m_onInfoResponse);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
+  return _swig_go_result;
 }
 
 
-XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_wrap_XsOutputConfigurationArray_opAssign_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *result = 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_result;
+void _wrap_SetCallbackHandler_M_onError_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
+  
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onError = arg2;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *) &(swig_b0)->operator =((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl const &)*arg2);
-  *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-bool _wrap_XsOutputConfigurationArray_empty_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  bool result;
-  bool _swig_go_result;
+void* _wrap_GetCallbackHandler_M_onError_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
+  void* _swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (bool)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->empty();
-  _swig_go_result = result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b1)->m_onError);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_inherited__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_SetCallbackHandler_M_onNonDataMessage_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onNonDataMessage = arg2;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfigurationArray *) &((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->inherited();
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_inherited__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void* _wrap_GetCallbackHandler_M_onNonDataMessage_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfigurationArray *) &(swig_b0)->inherited();
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onNonDataMessage);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_swap__SWIG_0_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl *arg2 = 0 ;
+void _wrap_SetCallbackHandler_M_onMessageDetected_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray >::ArrayImpl **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->swap(*arg2);
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onMessageDetected = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_swap__SWIG_1_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, long long _swig_go_1, long long _swig_go_2) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsSize arg2 ;
-  XsSize arg3 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
-  arg3 = (size_t)_swig_go_2; 
+void* _wrap_GetCallbackHandler_M_onMessageDetected_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->swap(arg2,arg3);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b1)->m_onMessageDetected);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
+  return _swig_go_result;
 }
 
 
-XsOutputConfigurationArray *_wrap_XsOutputConfigurationArray_opLeftShift_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  XsOutputConfigurationArray *result = 0 ;
-  XsOutputConfigurationArray *_swig_go_result;
+void _wrap_SetCallbackHandler_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onMessageReceivedFromDevice = arg2;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (XsOutputConfigurationArray *) &(swig_b0)->operator <<((XsOutputConfiguration const &)*arg2);
-  *(XsOutputConfigurationArray **)&_swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_XsOutputConfigurationArray_find_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, XsOutputConfiguration *_swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsOutputConfiguration *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+void* _wrap_GetCallbackHandler_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsOutputConfiguration **)&_swig_go_1; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  result = (int)((XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > const *)swig_b0)->find((XsOutputConfiguration const &)*arg2);
-  _swig_go_result = result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onMessageReceivedFromDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_removeDuplicates_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+void _wrap_SetCallbackHandler_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->removeDuplicates();
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onMessageSentToDevice = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_removeDuplicatesPredicate_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, void* _swig_go_1) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  XsArrayItemCompareFunc arg2 = (XsArrayItemCompareFunc) 0 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
-  arg2 = *(XsArrayItemCompareFunc *)&_swig_go_1; 
+void* _wrap_GetCallbackHandler_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->removeDuplicatesPredicate(arg2);
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onMessageSentToDevice);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_XsOutputConfigurationArray_sort_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+void _wrap_SetCallbackHandler_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->sort();
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onAllLiveDataAvailable = arg2;
   
 }
 
 
-void _wrap_XsOutputConfigurationArray_reverse_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
-  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
-  
-  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+void* _wrap_GetCallbackHandler_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
   
-  XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *swig_b0 = (XsArrayImpl< XsOutputConfiguration,g_xsOutputConfigurationArrayDescriptor,XsOutputConfigurationArray > *)arg1;
-  (swig_b0)->reverse();
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllLiveDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  return _swig_go_result;
 }
 
 
-CallbackHandler *_wrap_new_CallbackHandler__SWIG_0_gen_be9d2f14c67e6fa7(long long _swig_go_0) {
-  size_t arg1 ;
-  CallbackHandler *result = 0 ;
-  CallbackHandler *_swig_go_result;
+void _wrap_SetCallbackHandler_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
-  arg1 = (size_t)_swig_go_0; 
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onAllBufferedDataAvailable = arg2;
   
-  result = (CallbackHandler *)new CallbackHandler(arg1);
-  *(CallbackHandler **)&_swig_go_result = (CallbackHandler *)result; 
-  return _swig_go_result;
 }
 
 
-CallbackHandler *_wrap_new_CallbackHandler__SWIG_1_gen_be9d2f14c67e6fa7() {
-  CallbackHandler *result = 0 ;
-  CallbackHandler *_swig_go_result;
+void* _wrap_GetCallbackHandler_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
+  void* _swig_go_result;
   
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  result = (CallbackHandler *)new CallbackHandler();
-  *(CallbackHandler **)&_swig_go_result = (CallbackHandler *)result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllBufferedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_CallbackHandler_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void _wrap_SetCallbackHandler_M_onDataUnavailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
   
-  delete arg1;
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onDataUnavailable = arg2;
   
 }
 
 
-bool _wrap_CallbackHandler_packetAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onDataUnavailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  bool result;
-  bool _swig_go_result;
+  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  result = (bool)((CallbackHandler const *)arg1)->packetAvailable();
-  _swig_go_result = result; 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b1)->m_onDataUnavailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
   return _swig_go_result;
 }
 
 
-XsDataPacket *_wrap_CallbackHandler_getNextPacket_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void _wrap_SetCallbackHandler_M_onDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  XsDataPacket result;
-  XsDataPacket *_swig_go_result;
+  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  
+  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+  
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  if (swig_b1) (swig_b1)->m_onDataAvailable = arg2;
+  
+}
+
+
+void* _wrap_GetCallbackHandler_M_onDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
+  void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
-  result = (arg1)->getNextPacket();
-  *(XsDataPacket **)&_swig_go_result = new XsDataPacket(result); 
+  XsCallback *swig_b0 = (XsCallback *)arg1;
+  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetCallbackHandler_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onDeviceStateChanged = arg2;
+  if (swig_b1) (swig_b1)->m_onAllDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onDeviceStateChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState)) ((swig_b1)->m_onDeviceStateChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDeviceState,XsDeviceState))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetCallbackHandler_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
   void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
   
@@ -27680,12 +28837,12 @@ void _wrap_SetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(Callbac
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onLiveDataAvailable = arg2;
+  if (swig_b1) (swig_b1)->m_onRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
   void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
   void* _swig_go_result;
@@ -27694,629 +28851,856 @@ void* _wrap_GetCallbackHandler_M_onLiveDataAvailable_gen_be9d2f14c67e6fa7(Callba
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onLiveDataAvailable);
+  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onRecordedDataAvailable);
   *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onMissedPackets_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetCallbackHandler_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,int) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onMissedPackets = arg2;
+  if (swig_b1) (swig_b1)->m_onAllRecordedDataAvailable = arg2;
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onMissedPackets_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,int) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int)) ((swig_b1)->m_onMissedPackets);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,int))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,int))result; 
+  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllRecordedDataAvailable);
+  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onWakeupReceived_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetCallbackHandler_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *) = (void (*)(XsCallbackPlainC *,XsDevice *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onWakeupReceived = arg2;
+  if (swig_b1) (swig_b1)->m_onTransmissionRequest = arg2;
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onWakeupReceived_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *) = 0 ;
+  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *)) ((swig_b1)->m_onWakeupReceived);
-  *(void (**)(XsCallbackPlainC *,XsDevice *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *))result; 
+  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b1)->m_onTransmissionRequest);
+  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onProgressUpdated_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_SetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) 0 ;
+  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_1; 
+  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onProgressUpdated = arg2;
+  if (swig_b1) (swig_b1)->m_onRestoreCommunication = arg2;
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onProgressUpdated_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
+void* _wrap_GetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *) = 0 ;
+  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
   void* _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
   
   XsCallback *swig_b0 = (XsCallback *)arg1;
   XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *)) ((swig_b1)->m_onProgressUpdated);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int,int,XsString const *))result; 
+  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b1)->m_onRestoreCommunication);
+  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_addCallbackHandler_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, XsDevice *_swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  int (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onWriteMessageToLogFile = arg2;
+  addCallbackHandler(arg1,arg2);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onWriteMessageToLogFile_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  int (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_vectorSize_gen_be9d2f14c67e6fa7(XsVector *_swig_go_0) {
+  XsVector *arg1 = (XsVector *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsVector **)&_swig_go_0; 
+  
+  result = (int)vectorSize((XsVector const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+double _wrap_vectorAt_gen_be9d2f14c67e6fa7(XsVector *_swig_go_0, intgo _swig_go_1) {
+  XsVector *arg1 = (XsVector *) 0 ;
+  int arg2 ;
+  double result;
+  double _swig_go_result;
+  
+  arg1 = *(XsVector **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  result = (double)vectorAt((XsVector const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_deleteVector_gen_be9d2f14c67e6fa7(XsVector *_swig_go_0) {
+  XsVector *arg1 = (XsVector *) 0 ;
+  
+  arg1 = *(XsVector **)&_swig_go_0; 
+  
+  deleteVector(arg1);
+  
+}
+
+
+intgo _wrap_resultCode_gen_be9d2f14c67e6fa7(XsResultValue *_swig_go_0) {
+  XsResultValue *arg1 = (XsResultValue *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsResultValue **)&_swig_go_0; 
+  
+  result = (int)resultCode((XsResultValue const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_deleteResultValue_gen_be9d2f14c67e6fa7(XsResultValue *_swig_go_0) {
+  XsResultValue *arg1 = (XsResultValue *) 0 ;
+  
+  arg1 = *(XsResultValue **)&_swig_go_0; 
+  
+  deleteResultValue(arg1);
+  
+}
+
+
+XsString *_wrap_versionString_gen_be9d2f14c67e6fa7(XsVersion *_swig_go_0) {
+  XsVersion *arg1 = (XsVersion *) 0 ;
+  XsString result;
+  XsString *_swig_go_result;
+  
+  arg1 = *(XsVersion **)&_swig_go_0; 
+  
+  result = versionString((XsVersion const *)arg1);
+  *(XsString **)&_swig_go_result = new XsString(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_deleteVersion_gen_be9d2f14c67e6fa7(XsVersion *_swig_go_0) {
+  XsVersion *arg1 = (XsVersion *) 0 ;
+  
+  arg1 = *(XsVersion **)&_swig_go_0; 
+  
+  deleteVersion(arg1);
+  
+}
+
+
+intgo _wrap_baudRateToNumeric_gen_be9d2f14c67e6fa7(intgo _swig_go_0) {
+  XsBaudRate arg1 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = (XsBaudRate)_swig_go_0; 
+  
+  result = (int)baudRateToNumeric(arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_numericToBaudRate_gen_be9d2f14c67e6fa7(intgo _swig_go_0) {
+  int arg1 ;
+  XsBaudRate result;
+  intgo _swig_go_result;
+  
+  arg1 = (int)_swig_go_0; 
+  
+  result = (XsBaudRate)numericToBaudRate(arg1);
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_filterProfileType_gen_be9d2f14c67e6fa7(XsFilterProfile *_swig_go_0) {
+  XsFilterProfile *arg1 = (XsFilterProfile *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsFilterProfile **)&_swig_go_0; 
+  
+  result = (int)filterProfileType((XsFilterProfile const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_filterProfileVersion_gen_be9d2f14c67e6fa7(XsFilterProfile *_swig_go_0) {
+  XsFilterProfile *arg1 = (XsFilterProfile *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsFilterProfile **)&_swig_go_0; 
+  
+  result = (int)filterProfileVersion((XsFilterProfile const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+XsString *_wrap_filterProfileLabel_gen_be9d2f14c67e6fa7(XsFilterProfile *_swig_go_0) {
+  XsFilterProfile *arg1 = (XsFilterProfile *) 0 ;
+  XsString result;
+  XsString *_swig_go_result;
+  
+  arg1 = *(XsFilterProfile **)&_swig_go_0; 
+  
+  result = filterProfileLabel((XsFilterProfile const *)arg1);
+  *(XsString **)&_swig_go_result = new XsString(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_deleteFilterProfile_gen_be9d2f14c67e6fa7(XsFilterProfile *_swig_go_0) {
+  XsFilterProfile *arg1 = (XsFilterProfile *) 0 ;
+  
+  arg1 = *(XsFilterProfile **)&_swig_go_0; 
+  
+  deleteFilterProfile(arg1);
+  
+}
+
+
+intgo _wrap_outputConfigurationCount_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  
+  result = (int)outputConfigurationCount((XsOutputConfigurationArray const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_outputConfigurationIdentifier_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, intgo _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  result = (int)outputConfigurationIdentifier((XsOutputConfigurationArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_outputConfigurationFrequency_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, intgo _swig_go_1) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  result = (int)outputConfigurationFrequency((XsOutputConfigurationArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_appendOutputConfiguration_gen_be9d2f14c67e6fa7(XsOutputConfigurationArray *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2) {
+  XsOutputConfigurationArray *arg1 = (XsOutputConfigurationArray *) 0 ;
+  int arg2 ;
+  int arg3 ;
+  
+  arg1 = *(XsOutputConfigurationArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  
+  appendOutputConfiguration(arg1,arg2,arg3);
+  
+}
+
+
+XsOutputConfigurationArray *_wrap_newOutputConfigurationArray_gen_be9d2f14c67e6fa7() {
+  XsOutputConfigurationArray *result = 0 ;
+  XsOutputConfigurationArray *_swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onWriteMessageToLogFile);
-  *(int (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (int (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (XsOutputConfigurationArray *)newOutputConfigurationArray();
+  *(XsOutputConfigurationArray **)&_swig_go_result = (XsOutputConfigurationArray *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+XsQuaternion *_wrap_alignmentRotation_gen_be9d2f14c67e6fa7(XsDevice *_swig_go_0, intgo _swig_go_1) {
+  XsDevice *arg1 = (XsDevice *) 0 ;
+  int arg2 ;
+  XsQuaternion result;
+  XsQuaternion *_swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onBufferedDataAvailable = arg2;
+  arg1 = *(XsDevice **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = alignmentRotation((XsDevice const *)arg1,arg2);
+  *(XsQuaternion **)&_swig_go_result = new XsQuaternion(result); 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+bool _wrap_setAlignmentRotation_gen_be9d2f14c67e6fa7(XsDevice *_swig_go_0, intgo _swig_go_1, XsQuaternion *_swig_go_2) {
+  XsDevice *arg1 = (XsDevice *) 0 ;
+  int arg2 ;
+  XsQuaternion *arg3 = 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDevice **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = *(XsQuaternion **)&_swig_go_2; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (bool)setAlignmentRotation(arg1,arg2,(XsQuaternion const &)*arg3);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_1; 
+intgo _wrap_syncSettingCount_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onConnectivityChanged = arg2;
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
   
+  result = (int)syncSettingCount((XsSyncSettingArray const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onConnectivityChanged_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsConnectivityState) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_syncSettingLine_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState)) ((swig_b1)->m_onConnectivityChanged);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsConnectivityState))result; 
+  result = (int)syncSettingLine((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onInfoResponse_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_1; 
+intgo _wrap_syncSettingFunction_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onInfoResponse = arg2;
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)syncSettingFunction((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onInfoResponse_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsInfoRequest) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_syncSettingPolarity_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest)) ((swig_b1)->m_onInfoResponse);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsInfoRequest))result; 
+  result = (int)syncSettingPolarity((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onError_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_1; 
+intgo _wrap_syncSettingPulseWidth_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onError = arg2;
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)syncSettingPulseWidth((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onError_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsResultValue) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_syncSettingOffset_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue)) ((swig_b1)->m_onError);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsResultValue))result; 
+  result = (int)syncSettingOffset((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onNonDataMessage_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+intgo _wrap_syncSettingSkipFirst_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onNonDataMessage = arg2;
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)syncSettingSkipFirst((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onNonDataMessage_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_syncSettingSkipFactor_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onNonDataMessage);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (int)syncSettingSkipFactor((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onMessageDetected_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_1; 
+intgo _wrap_syncSettingClockPeriod_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onMessageDetected = arg2;
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)syncSettingClockPeriod((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onMessageDetected_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *) = 0 ;
-  void* _swig_go_result;
+bool _wrap_syncSettingTriggerOnce_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *)) ((swig_b1)->m_onMessageDetected);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsProtocolType,XsByteArray const *))result; 
+  result = (bool)syncSettingTriggerOnce((XsSyncSettingArray const *)arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+void _wrap_appendSyncSetting_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, intgo _swig_go_3, intgo _swig_go_4, intgo _swig_go_5, intgo _swig_go_6, intgo _swig_go_7, intgo _swig_go_8, bool _swig_go_9) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
+  int arg2 ;
+  int arg3 ;
+  int arg4 ;
+  int arg5 ;
+  int arg6 ;
+  int arg7 ;
+  int arg8 ;
+  int arg9 ;
+  bool arg10 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
+  arg5 = (int)_swig_go_4; 
+  arg6 = (int)_swig_go_5; 
+  arg7 = (int)_swig_go_6; 
+  arg8 = (int)_swig_go_7; 
+  arg9 = (int)_swig_go_8; 
+  arg10 = (bool)_swig_go_9; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onMessageReceivedFromDevice = arg2;
+  appendSyncSetting(arg1,arg2,arg3,arg4,arg5,arg6,arg7,arg8,arg9,arg10);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onMessageReceivedFromDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+XsSyncSettingArray *_wrap_newSyncSettingArray_gen_be9d2f14c67e6fa7() {
+  XsSyncSettingArray *result = 0 ;
+  XsSyncSettingArray *_swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onMessageReceivedFromDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (XsSyncSettingArray *)newSyncSettingArray();
+  *(XsSyncSettingArray **)&_swig_go_result = (XsSyncSettingArray *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) 0 ;
+void _wrap_deleteSyncSettingArray_gen_be9d2f14c67e6fa7(XsSyncSettingArray *_swig_go_0) {
+  XsSyncSettingArray *arg1 = (XsSyncSettingArray *) 0 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_1; 
+  arg1 = *(XsSyncSettingArray **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onMessageSentToDevice = arg2;
+  deleteSyncSettingArray(arg1);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onMessageSentToDevice_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsMessage const *) = 0 ;
-  void* _swig_go_result;
+bool _wrap_resetOrientation_gen_be9d2f14c67e6fa7(XsDevice *_swig_go_0, intgo _swig_go_1) {
+  XsDevice *arg1 = (XsDevice *) 0 ;
+  int arg2 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDevice **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *)) ((swig_b1)->m_onMessageSentToDevice);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsMessage const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsMessage const *))result; 
+  result = (bool)resetOrientation(arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+intgo _wrap_missedPacketCount_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onAllLiveDataAvailable = arg2;
+  result = (int)missedPacketCount((CallbackHandler const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+XsDevice *_wrap_openLogFileDevice_gen_be9d2f14c67e6fa7(XsControl *_swig_go_0, XsString *_swig_go_1) {
+  XsControl *arg1 = (XsControl *) 0 ;
+  XsString *arg2 = 0 ;
+  XsDevice *result = 0 ;
+  XsDevice *_swig_go_result;
+  
+  arg1 = *(XsControl **)&_swig_go_0; 
+  arg2 = *(XsString **)&_swig_go_1; 
   
+  result = (XsDevice *)openLogFileDevice(arg1,(XsString const &)*arg2);
+  *(XsDevice **)&_swig_go_result = (XsDevice *)result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onAllLiveDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+double _wrap_utcTimeSeconds_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  double result;
+  double _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllLiveDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (double)utcTimeSeconds((XsDataPacket const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+void _wrap_removeCallbackHandler_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, XsDevice *_swig_go_1) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+  XsDevice *arg2 = (XsDevice *) 0 ;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg2 = *(XsDevice **)&_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onAllBufferedDataAvailable = arg2;
+  removeCallbackHandler(arg1,arg2);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onAllBufferedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+bool _wrap_syncSettingsCompatible_gen_be9d2f14c67e6fa7(XsDevice *_swig_go_0, XsSyncSettingArray *_swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+  XsDevice *arg1 = (XsDevice *) 0 ;
+  XsSyncSettingArray *arg2 = (XsSyncSettingArray *) 0 ;
+  int arg3 ;
+  int arg4 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDevice **)&_swig_go_0; 
+  arg2 = *(XsSyncSettingArray **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllBufferedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (bool)syncSettingsCompatible((XsDevice const *)arg1,(XsSyncSettingArray const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onDataUnavailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,int64_t) = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_1; 
+bool _wrap_containsTriggerIndication_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, intgo _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int arg2 ;
+  bool result;
+  bool _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onDataUnavailable = arg2;
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (bool)containsTriggerIndication((XsDataPacket const *)arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onDataUnavailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,int64_t) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_triggerIndicationLine_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, intgo _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t)) ((swig_b1)->m_onDataUnavailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,int64_t))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,int64_t))result; 
+  result = (int)triggerIndicationLine(arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
+intgo _wrap_triggerIndicationPolarity_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, intgo _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onDataAvailable = arg2;
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)triggerIndicationPolarity(arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+double _wrap_triggerIndicationTimestamp_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, intgo _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int arg2 ;
+  double result;
+  double _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (double)triggerIndicationTimestamp(arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+intgo _wrap_triggerIndicationFrameNumber_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0, intgo _swig_go_1) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onAllDataAvailable = arg2;
+  result = (int)triggerIndicationFrameNumber(arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+double _wrap_timeOfArrivalMs_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  double result;
+  double _swig_go_result;
+  
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
   
+  result = (double)timeOfArrivalMs((XsDataPacket const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onAllDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+double _wrap_estimatedTimeOfSamplingMs_gen_be9d2f14c67e6fa7(XsDataPacket *_swig_go_0) {
+  XsDataPacket *arg1 = (XsDataPacket *) 0 ;
+  double result;
+  double _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDataPacket **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
+  result = (double)estimatedTimeOfSamplingMs((XsDataPacket const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
+intgo _wrap_droppedPacketCount_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
   CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) 0 ;
+  int result;
+  intgo _swig_go_result;
   
   arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_1; 
-  
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onRecordedDataAvailable = arg2;
   
+  result = (int)droppedPacketCount((CallbackHandler const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_runSelfTest_gen_be9d2f14c67e6fa7(XsDevice *_swig_go_0) {
+  XsDevice *arg1 = (XsDevice *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsDevice **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *)) ((swig_b1)->m_onRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevice *,XsDataPacket const *))result; 
+  result = (int)runSelfTest(arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) 0 ;
+void _wrap_enableScanLog_gen_be9d2f14c67e6fa7(bool _swig_go_0) {
+  bool arg1 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_1; 
+  arg1 = (bool)_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onAllRecordedDataAvailable = arg2;
+  enableScanLog(arg1);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onAllRecordedDataAvailable_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *) = 0 ;
-  void* _swig_go_result;
+void _wrap_setJournalLogLevel_gen_be9d2f14c67e6fa7(intgo _swig_go_0) {
+  int arg1 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = (int)_swig_go_0; 
+  
+  setJournalLogLevel(arg1);
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *)) ((swig_b1)->m_onAllRecordedDataAvailable);
-  *(void (**)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsDevicePtrArray *,XsDataPacketPtrArray const *))result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,int,XsByteArray const *) = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) 0 ;
+void _wrap_openJournalFile_gen_be9d2f14c67e6fa7(XsString *_swig_go_0, intgo _swig_go_1) {
+  XsString *arg1 = 0 ;
+  int arg2 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_1; 
+  arg1 = *(XsString **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onTransmissionRequest = arg2;
+  openJournalFile((XsString const &)*arg1,arg2);
   
 }
 
 
-void* _wrap_GetCallbackHandler_M_onTransmissionRequest_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,int,XsByteArray const *) = 0 ;
-  void* _swig_go_result;
+double _wrap_iccDdtAccuracy_gen_be9d2f14c67e6fa7(XsIccRepMotionResult *_swig_go_0) {
+  XsIccRepMotionResult *arg1 = (XsIccRepMotionResult *) 0 ;
+  double result;
+  double _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsIccRepMotionResult **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *)) ((swig_b1)->m_onTransmissionRequest);
-  *(void (**)(XsCallbackPlainC *,int,XsByteArray const *))&_swig_go_result = (void (*)(XsCallbackPlainC *,int,XsByteArray const *))result; 
+  result = (double)iccDdtAccuracy((XsIccRepMotionResult const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, void* _swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*arg2)(XsCallbackPlainC *,XsString const *,XsResultValue) = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) 0 ;
-  
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_1; 
+intgo _wrap_iccDimension_gen_be9d2f14c67e6fa7(XsIccRepMotionResult *_swig_go_0) {
+  XsIccRepMotionResult *arg1 = (XsIccRepMotionResult *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  if (swig_b1) (swig_b1)->m_onRestoreCommunication = arg2;
+  arg1 = *(XsIccRepMotionResult **)&_swig_go_0; 
   
+  result = (int)iccDimension((XsIccRepMotionResult const *)arg1);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void* _wrap_GetCallbackHandler_M_onRestoreCommunication_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  void (*result)(XsCallbackPlainC *,XsString const *,XsResultValue) = 0 ;
-  void* _swig_go_result;
+intgo _wrap_iccStatus_gen_be9d2f14c67e6fa7(XsIccRepMotionResult *_swig_go_0) {
+  XsIccRepMotionResult *arg1 = (XsIccRepMotionResult *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
+  arg1 = *(XsIccRepMotionResult **)&_swig_go_0; 
   
-  XsCallback *swig_b0 = (XsCallback *)arg1;
-  XsCallbackPlainC *swig_b1 = (XsCallbackPlainC *)swig_b0;
-  result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue)) ((swig_b1)->m_onRestoreCommunication);
-  *(void (**)(XsCallbackPlainC *,XsString const *,XsResultValue))&_swig_go_result = (void (*)(XsCallbackPlainC *,XsString const *,XsResultValue))result; 
+  result = (int)iccStatus((XsIccRepMotionResult const *)arg1);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_addCallbackHandler_gen_be9d2f14c67e6fa7(CallbackHandler *_swig_go_0, XsDevice *_swig_go_1) {
-  CallbackHandler *arg1 = (CallbackHandler *) 0 ;
-  XsDevice *arg2 = (XsDevice *) 0 ;
+void _wrap_deleteIccRepMotionResult_gen_be9d2f14c67e6fa7(XsIccRepMotionResult *_swig_go_0) {
+  XsIccRepMotionResult *arg1 = (XsIccRepMotionResult *) 0 ;
   
-  arg1 = *(CallbackHandler **)&_swig_go_0; 
-  arg2 = *(XsDevice **)&_swig_go_1; 
+  arg1 = *(XsIccRepMotionResult **)&_swig_go_0; 
   
-  addCallbackHandler(arg1,arg2);
+  deleteIccRepMotionResult(arg1);
   
 }
 
@@ -0,0 +1,13 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic -I${SRCDIR}/third_party/xspublic/xscontroller
+// #include "xsicccommand.h"
+import "C"
+
+// XsIccStatusFlag values, taken from the SDK's header.
+const (
+	XISF_ddtWarning    = int(C.XISF_ddtWarning)
+	XISF_notEnoughData = int(C.XISF_notEnoughData)
+	XISF_OutputStable  = int(C.XISF_OutputStable)
+	XISF_RepMoActive   = int(C.XISF_RepMoActive)
+)
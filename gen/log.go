@@ -0,0 +1,80 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic/xscommon
+// #include "journalloglevel.h"
+import "C"
+
+import "sync"
+
+// JournalLogLevel values, the levels of the SDK's journal, taken from its
+// header, which misspells JLL_Disable.
+const (
+	JournalTrace   = int(C.JLL_Trace)
+	JournalDebug   = int(C.JLL_Debug)
+	JournalAlert   = int(C.JLL_Alert)
+	JournalError   = int(C.JLL_Error)
+	JournalFatal   = int(C.JLL_Fatal)
+	JournalWrite   = int(C.JLL_Write)
+	JournalDisable = int(C.JLL_Diable)
+)
+
+// journalLog is the handler of the entries of the SDK's journal.
+var journalLog struct {
+	sync.Mutex
+	handler func(level int, function, msg string)
+}
+
+// SetJournalHandler sends the entries of the SDK's journal from level up to
+// handler, or stops sending them when handler is nil.
+func SetJournalHandler(level int, handler func(level int, function, msg string)) {
+	journalLog.Lock()
+	journalLog.handler = handler
+	journalLog.Unlock()
+	if handler == nil {
+		level = JournalDisable
+	}
+	SetJournalLogLevel(level)
+}
+
+// OpenJournal has the SDK write its journal from level up to the file at
+// path. A journal already open moves to path.
+func OpenJournal(path string, level int) {
+	pathStr := NewXSString(path)
+	defer DeleteXSString(pathStr)
+	OpenJournalFile(pathStr, level)
+}
+
+//export xsensJournalLog
+func xsensJournalLog(level C.int, function, msg *C.char) {
+	journalLog.Lock()
+	handler := journalLog.handler
+	journalLog.Unlock()
+	if handler != nil {
+		handler(int(level), C.GoString(function), C.GoString(msg))
+	}
+}
+
+// scanLog is the handler of the lines logged by the SDK's port scanner.
+var scanLog struct {
+	sync.Mutex
+	handler func(string)
+}
+
+// SetScanLogHandler sends the lines the SDK's port scanner logs to handler,
+// or stops sending them when handler is nil.
+func SetScanLogHandler(handler func(string)) {
+	scanLog.Lock()
+	scanLog.handler = handler
+	scanLog.Unlock()
+	EnableScanLog(handler != nil)
+}
+
+//export xsensScanLog
+func xsensScanLog(line *C.char) {
+	scanLog.Lock()
+	handler := scanLog.handler
+	scanLog.Unlock()
+	if handler != nil {
+		handler(C.GoString(line))
+	}
+}
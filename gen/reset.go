@@ -0,0 +1,13 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic/xstypes
+// #include "xsresetmethod.h"
+import "C"
+
+// XsResetMethod values, taken from the SDK's header.
+const (
+	XRM_Heading            = int(C.XRM_Heading)
+	XRM_Inclination        = int(C.XRM_Inclination)
+	XRM_DefaultHeading     = int(C.XRM_DefaultHeading)
+	XRM_DefaultInclination = int(C.XRM_DefaultInclination)
+)
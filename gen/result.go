@@ -0,0 +1,30 @@
+package gen
+
+// #cgo CFLAGS: -I${SRCDIR}/third_party/xspublic/xstypes
+// #include "xsresultvalue.h"
+import "C"
+
+// XsResultValue values, taken from the SDK's header.
+const (
+	XRV_OK                    = int(C.XRV_OK)
+	XRV_BAUDRATEINVALID       = int(C.XRV_BAUDRATEINVALID)
+	XRV_INVALIDPARAM          = int(C.XRV_INVALIDPARAM)
+	XRV_INVALIDFILTERPROFILE  = int(C.XRV_INVALIDFILTERPROFILE)
+	XRV_ACCESSDENIED          = int(C.XRV_ACCESSDENIED)
+	XRV_OUTPUTCONFIGERROR     = int(C.XRV_OUTPUTCONFIGERROR)
+	XRV_TIMEOUT               = int(C.XRV_TIMEOUT)
+	XRV_TIMEOUTNODATA         = int(C.XRV_TIMEOUTNODATA)
+	XRV_NOTFOUND              = int(C.XRV_NOTFOUND)
+	XRV_INVALIDID             = int(C.XRV_INVALIDID)
+	XRV_INPUTCANNOTBEOPENED   = int(C.XRV_INPUTCANNOTBEOPENED)
+	XRV_ALREADYOPEN           = int(C.XRV_ALREADYOPEN)
+	XRV_BUSY                  = int(C.XRV_BUSY)
+	XRV_NOPORTOPEN            = int(C.XRV_NOPORTOPEN)
+	XRV_NOFILEORPORTOPEN      = int(C.XRV_NOFILEORPORTOPEN)
+	XRV_PORTNOTFOUND          = int(C.XRV_PORTNOTFOUND)
+	XRV_CONFIGCHECKFAIL       = int(C.XRV_CONFIGCHECKFAIL)
+	XRV_UNSUPPORTED           = int(C.XRV_UNSUPPORTED)
+	XRV_UNEXPECTED_DISCONNECT = int(C.XRV_UNEXPECTED_DISCONNECT)
+	XRV_EXPECTED_DISCONNECT   = int(C.XRV_EXPECTED_DISCONNECT)
+	XRV_IN_USE                = int(C.XRV_IN_USE)
+)
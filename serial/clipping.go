@@ -0,0 +1,137 @@
+package serial
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// clipRangeFraction is the fraction of a sensor's range at which a sample is
+// taken as clipped even when the device did not flag it.
+const clipRangeFraction = 0.98
+
+// ClipCounts counts the samples clipped per axis of each sensor.
+type ClipCounts struct {
+	Accelerometer [3]int64 `json:"accelerometer"`
+	Gyroscope     [3]int64 `json:"gyroscope"`
+	Magnetometer  [3]int64 `json:"magnetometer"`
+	// Saturated counts the samples with any axis clipped.
+	Saturated int64 `json:"saturated"`
+}
+
+// clipDetector marks samples in which a sensor clipped, from the clipping
+// bits of the detailed status and from values at the edge of the sensors'
+// ranges.
+type clipDetector struct {
+	mu sync.Mutex
+	// accRange in m/s^2 and gyrRange in rad/s are the sensors' ranges, or 0
+	// when unknown.
+	accRange, gyrRange float64
+	counts             ClipCounts
+	saturated          bool
+	lastSaturated      time.Time
+}
+
+// newClipDetector returns a detector for the sensor ranges of device, which
+// may be nil when the device is not connected yet.
+func newClipDetector(device gen.XSDevice) *clipDetector {
+	d := &clipDetector{}
+	if device != nil {
+		d.setRanges(device)
+	}
+	return d
+}
+
+// setRanges reads the sensor ranges of device, which the SDK gives in m/s^2
+// and deg/s.
+func (d *clipDetector) setRanges(device gen.XSDevice) {
+	accRange := device.AccelerometerRange()
+	gyrRange := device.GyroscopeRange() * math.Pi / 180
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.accRange, d.gyrRange = accRange, gyrRange
+}
+
+// update checks packet for clipping and returns whether it is saturated.
+func (d *clipDetector) update(packet gen.XSDataPacket) bool {
+	var acc, gyr, mag [3]bool
+	if packet.ContainsDetailedStatus() {
+		status := uint32(packet.Status())
+		for axis := uint32(0); axis < 3; axis++ {
+			acc[axis] = status&(statusClipAccX<<axis) != 0
+			gyr[axis] = status&(statusClipGyrX<<axis) != 0
+			mag[axis] = status&(statusClipMagX<<axis) != 0
+		}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.accRange > 0 && packet.ContainsCalibratedAcceleration() {
+		atRange(&acc, vector3(packet.CalibratedAcceleration()), d.accRange)
+	}
+	if d.gyrRange > 0 && packet.ContainsCalibratedGyroscopeData() {
+		atRange(&gyr, vector3(packet.CalibratedGyroscopeData()), d.gyrRange)
+	}
+
+	saturated := false
+	for axis := 0; axis < 3; axis++ {
+		for _, clip := range []struct {
+			clipped bool
+			count   *int64
+		}{
+			{acc[axis], &d.counts.Accelerometer[axis]},
+			{gyr[axis], &d.counts.Gyroscope[axis]},
+			{mag[axis], &d.counts.Magnetometer[axis]},
+		} {
+			if clip.clipped {
+				*clip.count++
+				saturated = true
+			}
+		}
+	}
+	if saturated {
+		d.counts.Saturated++
+		d.lastSaturated = time.Now()
+	}
+	d.saturated = saturated
+	return saturated
+}
+
+// atRange marks the axes of v at the edge of the range as clipped.
+func atRange(clipped *[3]bool, v r3.Vector, sensorRange float64) {
+	limit := clipRangeFraction * sensorRange
+	for axis, value := range []float64{v.X, v.Y, v.Z} {
+		if math.Abs(value) >= limit {
+			clipped[axis] = true
+		}
+	}
+}
+
+// Counts returns the clip counts so far.
+func (d *clipDetector) Counts() ClipCounts {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.counts
+}
+
+// readings returns whether the latest sample was saturated and the clip
+// counts.
+func (d *clipDetector) readings() map[string]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	counts := map[string]interface{}{
+		"accelerometer": append([]int64(nil), d.counts.Accelerometer[:]...),
+		"gyroscope":     append([]int64(nil), d.counts.Gyroscope[:]...),
+		"magnetometer":  append([]int64(nil), d.counts.Magnetometer[:]...),
+		"saturated":     d.counts.Saturated,
+	}
+	if !d.lastSaturated.IsZero() {
+		counts["last_saturated"] = d.lastSaturated.UTC().Format(time.RFC3339Nano)
+	}
+	return map[string]interface{}{
+		"saturated": d.saturated,
+		"clipping":  counts,
+	}
+}
@@ -0,0 +1,72 @@
+package serial
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+func TestAtRange(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		v    r3.Vector
+		want [3]bool
+	}{
+		{name: "inside", v: r3.Vector{X: 150, Y: -150, Z: 0}},
+		{name: "just below the limit", v: r3.Vector{X: 156.7}},
+		{name: "at the limit", v: r3.Vector{X: 156.8}, want: [3]bool{true, false, false}},
+		{name: "negative", v: r3.Vector{Y: -160}, want: [3]bool{false, true, false}},
+		{name: "all axes", v: r3.Vector{X: 160, Y: -157, Z: 200}, want: [3]bool{true, true, true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var clipped [3]bool
+			atRange(&clipped, tc.v, 160)
+			if clipped != tc.want {
+				t.Errorf("atRange(%v) = %v, want %v", tc.v, clipped, tc.want)
+			}
+		})
+	}
+}
+
+func TestClipDetectorStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		statuses      []uint32
+		want          ClipCounts
+		wantSaturated bool
+	}{
+		{
+			name:     "no clipping",
+			statuses: []uint32{statusSelfTestOK, statusClippingDetected},
+		},
+		{
+			name:     "one axis",
+			statuses: []uint32{statusClipAccX << 1, 0},
+			want:     ClipCounts{Accelerometer: [3]int64{0, 1, 0}, Saturated: 1},
+		},
+		{
+			name:          "several sensors",
+			statuses:      []uint32{statusClipGyrX | statusClipMagX<<2, statusClipGyrX},
+			want:          ClipCounts{Gyroscope: [3]int64{2, 0, 0}, Magnetometer: [3]int64{0, 0, 1}, Saturated: 2},
+			wantSaturated: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := newClipDetector(nil)
+			var saturated bool
+			for _, status := range tc.statuses {
+				packet := gen.NewXSDataPacket__SWIG_1()
+				packet.SetStatus(uint(status))
+				saturated = d.update(packet)
+				gen.DeleteXSDataPacket(packet)
+			}
+			if d.counts != tc.want {
+				t.Errorf("counts = %+v, want %+v", d.counts, tc.want)
+			}
+			if saturated != tc.wantSaturated {
+				t.Errorf("update = %v, want %v", saturated, tc.wantSaturated)
+			}
+		})
+	}
+}
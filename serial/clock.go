@@ -0,0 +1,402 @@
+package serial
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// clockWindow is how many of the latest packets the clock fit uses.
+	clockWindow = 3000
+	// clockMinSamples is how many packets are needed before the first fit.
+	clockMinSamples = 50
+	// clockFitInterval is how many packets arrive between fits.
+	clockFitInterval = 50
+	// clockOutlierMADs rejects arrivals further than this many scaled
+	// median absolute deviations from the fit, e.g. ones delayed by USB
+	// buffering.
+	clockOutlierMADs = 3
+	// clockOutlierFloor is the smallest residual rejected as an outlier,
+	// as arrival times only have millisecond resolution.
+	clockOutlierFloor = 2.0
+	// clockResetThreshold is how many milliseconds off the fit an arrival is
+	// dropped at. An arrival that early means the device time jumped or the
+	// host clock was stepped back, and restarts the fit at once.
+	clockResetThreshold = 1000.0
+	// clockResetOutliers is how many arrivals in a row that late restart the
+	// fit, e.g. after the host clock was stepped.
+	clockResetOutliers = 10
+)
+
+// clockSource is the device counter the clock is fit against.
+type clockSource int
+
+const (
+	clockSourceNone clockSource = iota
+	clockSourceSampleTimeFine
+	clockSourcePacketCounter
+)
+
+func (s clockSource) String() string {
+	switch s {
+	case clockSourceSampleTimeFine:
+		return "sample_time_fine"
+	case clockSourcePacketCounter:
+		return "packet_counter"
+	default:
+		return "none"
+	}
+}
+
+// SampleTime is when a packet was sampled, in host time.
+type SampleTime struct {
+	// Device is the device's time of the sample, unwrapped, when the packet
+	// has a SampleTimeFine.
+	Device time.Duration
+	// Host is the host's wall clock time of the sample.
+	Host time.Time
+	// Monotonic is the sample time on the host's CLOCK_MONOTONIC.
+	Monotonic time.Duration
+	// Uncertainty is the standard error of Host and Monotonic. It is only
+	// known once the clock is fitted.
+	Uncertainty time.Duration
+	Fitted      bool
+}
+
+// ClockFit is the current mapping from device time to host time.
+type ClockFit struct {
+	Source string `json:"source"`
+	// Offset is the host time of device time zero.
+	Offset time.Time `json:"offset"`
+	// Period is the host duration of one device tick or packet.
+	Period time.Duration `json:"period"`
+	// DriftPPM is how much faster, in parts per million, the device clock
+	// runs than the host clock. Only known for SampleTimeFine.
+	DriftPPM float64 `json:"drift_ppm"`
+	// Residual is the standard deviation of the arrival times around the
+	// fit, i.e. the transport jitter.
+	Residual time.Duration `json:"residual"`
+	Samples  int           `json:"samples"`
+	Outliers int           `json:"outliers"`
+	Resets   int           `json:"resets"`
+}
+
+// ClockMapper estimates the offset and drift between the device clock and
+// the host clock from the times packets arrive at, and maps the device time
+// of every packet to host time.
+//
+// It fits a line through the device time (SampleTimeFine, or PacketCounter
+// when there is none) and the SDK's TimeOfArrival of the latest packets,
+// rejecting arrivals delayed by transport buffering as outliers. Until
+// enough packets have arrived, the SDK's own EstimatedTimeOfSampling is used.
+type ClockMapper struct {
+	mu sync.Mutex
+
+	source clockSource
+	raw    uint32
+	device int64
+	// base are the device ticks and host milliseconds the window is relative
+	// to, which keeps the fit numerically stable
+	baseDevice int64
+	baseHost   float64
+
+	xs, ys    []float64
+	next      int
+	sinceFit  int
+	late      int
+	resets    int
+	fit       *lineFit
+	lastFit   ClockFit
+	hasResult bool
+}
+
+// NewClockMapper returns a ClockMapper without any packets.
+func NewClockMapper() *ClockMapper {
+	return &ClockMapper{}
+}
+
+// Update adds packet to the fit and returns its sample time in host time.
+func (m *ClockMapper) Update(packet gen.XSDataPacket) (SampleTime, bool) {
+	source, raw := clockSourceNone, uint32(0)
+	switch {
+	case packet.ContainsSampleTimeFine():
+		source, raw = clockSourceSampleTimeFine, uint32(packet.SampleTimeFine())
+	case packet.ContainsPacketCounter():
+		source, raw = clockSourcePacketCounter, uint32(packet.PacketCounter())
+	default:
+		return SampleTime{}, false
+	}
+	arrival := gen.TimeOfArrivalMs(packet)
+	if arrival <= 0 {
+		return SampleTime{}, false
+	}
+	// the SDK estimates the time of sampling from the arrival times unless
+	// the packet carries the device's own time, which is not host time
+	estimate := math.NaN()
+	if !packet.ContainsSampleTimeCoarse() {
+		if t := gen.EstimatedTimeOfSamplingMs(packet); t > 0 {
+			estimate = t
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	x := m.unwrap(source, raw)
+	sample := m.add(x, arrival, estimate)
+	if source == clockSourceSampleTimeFine {
+		sample.Device = time.Duration(x) * sampleTimeFineResolution
+	}
+	return sample, true
+}
+
+// unwrap returns the device counter, extended past its wrap around. The fit
+// restarts when the source changes or the counter jumps back, which happens
+// when the device restarts.
+func (m *ClockMapper) unwrap(source clockSource, raw uint32) int64 {
+	if source != m.source {
+		m.reset()
+		m.source = source
+		m.device = int64(raw)
+		m.raw = raw
+		return m.device
+	}
+	var delta int64
+	if source == clockSourceSampleTimeFine {
+		delta = int64(int32(raw - m.raw))
+	} else {
+		delta = int64(int16(uint16(raw) - uint16(m.raw)))
+	}
+	m.raw = raw
+	if delta < 0 {
+		m.reset()
+		m.device = int64(raw)
+		return m.device
+	}
+	m.device += delta
+	return m.device
+}
+
+func (m *ClockMapper) reset() {
+	if len(m.xs) > 0 {
+		m.resets++
+	}
+	m.xs, m.ys = m.xs[:0], m.ys[:0]
+	m.next, m.sinceFit, m.late = 0, 0, 0
+	m.fit = nil
+}
+
+// add adds an arrival to the window, refits the line when due and maps x to
+// host time. An arrival far off the fit is dropped, and restarts the fit when
+// it is early or follows enough late ones.
+func (m *ClockMapper) add(x int64, arrival, estimate float64) SampleTime {
+	if len(m.xs) == 0 {
+		m.baseDevice, m.baseHost = x, arrival
+	}
+	fx, fy := float64(x-m.baseDevice), arrival-m.baseHost
+	drop := false
+	if m.fit != nil {
+		switch residual := fy - m.fit.at(fx); {
+		case residual < -clockResetThreshold:
+			m.restart(x, arrival)
+			fx, fy = 0, 0
+		case residual > clockResetThreshold:
+			m.late++
+			if m.late < clockResetOutliers {
+				drop = true
+				break
+			}
+			m.restart(x, arrival)
+			fx, fy = 0, 0
+		default:
+			m.late = 0
+		}
+	}
+
+	if !drop {
+		m.append(fx, fy)
+	}
+
+	var sample SampleTime
+	hostMs := arrival
+	switch {
+	case m.fit != nil:
+		hostMs = m.baseHost + m.fit.at(fx)
+		sample.Uncertainty = msDuration(m.fit.standardError(fx))
+		sample.Fitted = true
+	case !math.IsNaN(estimate):
+		hostMs = estimate
+	}
+	sample.Host = time.Unix(0, int64(hostMs*1e6))
+	sample.Monotonic = hostMonotonic(sample.Host)
+	return sample
+}
+
+// restart starts the fit again from the arrival of device time x.
+func (m *ClockMapper) restart(x int64, arrival float64) {
+	m.reset()
+	m.baseDevice, m.baseHost = x, arrival
+}
+
+// append adds a point to the window and refits the line when due.
+func (m *ClockMapper) append(fx, fy float64) {
+	if len(m.xs) < clockWindow {
+		m.xs, m.ys = append(m.xs, fx), append(m.ys, fy)
+	} else {
+		m.xs[m.next], m.ys[m.next] = fx, fy
+		m.next = (m.next + 1) % clockWindow
+	}
+	m.sinceFit++
+	if len(m.xs) >= clockMinSamples && (m.fit == nil || m.sinceFit >= clockFitInterval) {
+		m.fit = robustLineFit(m.xs, m.ys)
+		m.sinceFit = 0
+		m.updateResult()
+	}
+}
+
+func (m *ClockMapper) updateResult() {
+	f := m.fit
+	fit := ClockFit{
+		Source:   m.source.String(),
+		Offset:   time.Unix(0, int64((m.baseHost+f.at(float64(-m.baseDevice)))*1e6)),
+		Period:   msDuration(f.slope),
+		Residual: msDuration(f.sigma),
+		Samples:  f.n,
+		Outliers: len(m.xs) - f.n,
+		Resets:   m.resets,
+	}
+	if m.source == clockSourceSampleTimeFine {
+		nominal := float64(sampleTimeFineResolution) / float64(time.Millisecond)
+		fit.DriftPPM = (nominal/f.slope - 1) * 1e6
+	}
+	m.lastFit = fit
+	m.hasResult = true
+}
+
+// Fit returns the current clock fit, or false before the first fit.
+func (m *ClockMapper) Fit() (ClockFit, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastFit, m.hasResult
+}
+
+// lineFit is y = intercept + slope*x fitted to n points.
+type lineFit struct {
+	slope, intercept float64
+	xMean, sxx       float64
+	sigma            float64
+	n                int
+}
+
+func (f *lineFit) at(x float64) float64 {
+	return f.intercept + f.slope*x
+}
+
+// standardError is the standard error of the fitted line at x.
+func (f *lineFit) standardError(x float64) float64 {
+	if f.n < 3 || f.sxx == 0 {
+		return f.sigma
+	}
+	return f.sigma * math.Sqrt(1/float64(f.n)+(x-f.xMean)*(x-f.xMean)/f.sxx)
+}
+
+// robustLineFit fits a line by least squares and refits it twice without
+// the points more than clockOutlierMADs median absolute deviations off.
+func robustLineFit(xs, ys []float64) *lineFit {
+	keep := make([]bool, len(xs))
+	for i := range keep {
+		keep[i] = true
+	}
+	fit := fitLine(xs, ys, keep)
+	residuals := make([]float64, 0, len(xs))
+	for iteration := 0; iteration < 2 && fit != nil; iteration++ {
+		residuals = residuals[:0]
+		for i := range xs {
+			residuals = append(residuals, ys[i]-fit.at(xs[i]))
+		}
+		median := medianOf(residuals)
+		deviations := make([]float64, len(residuals))
+		for i, r := range residuals {
+			deviations[i] = math.Abs(r - median)
+		}
+		// 1.4826 scales the MAD to a standard deviation for normal noise
+		limit := math.Max(clockOutlierMADs*1.4826*medianOf(deviations), clockOutlierFloor)
+		for i, d := range deviations {
+			keep[i] = d <= limit
+		}
+		refit := fitLine(xs, ys, keep)
+		if refit == nil {
+			break
+		}
+		fit = refit
+	}
+	return fit
+}
+
+// fitLine fits a line by least squares through the kept points.
+func fitLine(xs, ys []float64, keep []bool) *lineFit {
+	var n int
+	var sx, sy float64
+	for i := range xs {
+		if keep[i] {
+			n++
+			sx += xs[i]
+			sy += ys[i]
+		}
+	}
+	if n < 3 {
+		return nil
+	}
+	xMean, yMean := sx/float64(n), sy/float64(n)
+	var sxx, sxy float64
+	for i := range xs {
+		if keep[i] {
+			dx := xs[i] - xMean
+			sxx += dx * dx
+			sxy += dx * (ys[i] - yMean)
+		}
+	}
+	if sxx == 0 {
+		return nil
+	}
+	f := &lineFit{slope: sxy / sxx, xMean: xMean, sxx: sxx, n: n}
+	f.intercept = yMean - f.slope*xMean
+	var ss float64
+	for i := range xs {
+		if keep[i] {
+			r := ys[i] - f.at(xs[i])
+			ss += r * r
+		}
+	}
+	f.sigma = math.Sqrt(ss / float64(n-2))
+	return f
+}
+
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func msDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// hostMonotonic converts a wall clock time to CLOCK_MONOTONIC using their
+// current difference.
+func hostMonotonic(wall time.Time) time.Duration {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return 0
+	}
+	now := time.Now()
+	return wall.Sub(now) + time.Duration(ts.Nano())
+}
@@ -0,0 +1,175 @@
+package serial
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestClockMapperFit(t *testing.T) {
+	const (
+		packets = 6000
+		// ticks is the SampleTimeFine ticks between packets at 100 Hz
+		ticks = 100
+		// start is the host time, in milliseconds, of device time zero
+		start = 1.7e12
+	)
+	for _, tc := range []struct {
+		name string
+		// driftPPM is how much faster the device clock runs
+		driftPPM float64
+		// delayed is the fraction of arrivals held up by buffering
+		delayed float64
+		// arrival is called for every packet to change its device time or
+		// arrival, e.g. to step a clock
+		arrival    func(i int, x int64, ms float64) (int64, float64)
+		wantOffset float64
+		wantResets int
+	}{
+		{name: "no drift"},
+		{name: "fast device", driftPPM: 100, delayed: 0.05},
+		{name: "slow device", driftPPM: -50, delayed: 0.05},
+		{
+			name:     "single late arrival",
+			driftPPM: 20,
+			arrival: func(i int, x int64, ms float64) (int64, float64) {
+				if i == 3000 {
+					return x, ms + 5000
+				}
+				return x, ms
+			},
+		},
+		{
+			name:     "host clock stepped forward",
+			driftPPM: 20,
+			arrival: func(i int, x int64, ms float64) (int64, float64) {
+				if i >= 3000 {
+					return x, ms + 5000
+				}
+				return x, ms
+			},
+			wantOffset: 5000,
+			wantResets: 1,
+		},
+		{
+			name:     "device time jumped",
+			driftPPM: 20,
+			arrival: func(i int, x int64, ms float64) (int64, float64) {
+				if i >= 3000 {
+					return x + 100000, ms
+				}
+				return x, ms
+			},
+			wantOffset: -10000 / (1 + 20e-6),
+			wantResets: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			period := 0.1 / (1 + tc.driftPPM*1e-6)
+			m := NewClockMapper()
+			m.source = clockSourceSampleTimeFine
+			for i := 0; i < packets; i++ {
+				x := int64(i * ticks)
+				// arrivals are 0.5 to 1.5 ms after sampling, more when buffered
+				ms := start + float64(x)*period + 0.5 + rng.Float64()
+				if rng.Float64() < tc.delayed {
+					ms += 20 + 30*rng.Float64()
+				}
+				if tc.arrival != nil {
+					x, ms = tc.arrival(i, x, ms)
+				}
+				m.add(x, ms, math.NaN())
+			}
+
+			fit, ok := m.Fit()
+			if !ok {
+				t.Fatal("no fit")
+			}
+			wantOffset := time.Unix(0, int64((start+1+tc.wantOffset)*1e6))
+			if d := fit.Offset.Sub(wantOffset); d < -200*time.Microsecond || d > 200*time.Microsecond {
+				t.Errorf("Offset is %v off", d)
+			}
+			if math.Abs(fit.DriftPPM-tc.driftPPM) > 3 {
+				t.Errorf("DriftPPM = %v, want %v", fit.DriftPPM, tc.driftPPM)
+			}
+			if fit.Resets != tc.wantResets {
+				t.Errorf("Resets = %d, want %d", fit.Resets, tc.wantResets)
+			}
+			if fit.Residual > time.Millisecond {
+				t.Errorf("Residual = %v, want the jitter of at most 1ms", fit.Residual)
+			}
+		})
+	}
+}
+
+func TestClockMapperUnwrap(t *testing.T) {
+	type count struct {
+		source clockSource
+		raw    uint32
+	}
+	fine := func(raws ...uint32) []count {
+		counts := make([]count, len(raws))
+		for i, raw := range raws {
+			counts[i] = count{clockSourceSampleTimeFine, raw}
+		}
+		return counts
+	}
+	counter := func(raws ...uint32) []count {
+		counts := make([]count, len(raws))
+		for i, raw := range raws {
+			counts[i] = count{clockSourcePacketCounter, raw}
+		}
+		return counts
+	}
+	for _, tc := range []struct {
+		name       string
+		counts     []count
+		want       []int64
+		wantResets int
+	}{
+		{
+			name:   "SampleTimeFine wraparound",
+			counts: fine(math.MaxUint32-99, 0, 100),
+			want:   []int64{math.MaxUint32 - 99, math.MaxUint32 + 1, math.MaxUint32 + 101},
+		},
+		{
+			name:       "SampleTimeFine back",
+			counts:     fine(50000, 50100, 100),
+			want:       []int64{50000, 50100, 100},
+			wantResets: 1,
+		},
+		{
+			name:   "PacketCounter wraparound",
+			counts: counter(65534, 65535, 0, 1),
+			want:   []int64{65534, 65535, 65536, 65537},
+		},
+		{
+			name:       "PacketCounter back",
+			counts:     counter(5000, 5001, 3, 4),
+			want:       []int64{5000, 5001, 3, 4},
+			wantResets: 1,
+		},
+		{
+			name:       "source changed",
+			counts:     append(counter(5000, 5001), fine(100, 200)...),
+			want:       []int64{5000, 5001, 100, 200},
+			wantResets: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewClockMapper()
+			for i, c := range tc.counts {
+				x := m.unwrap(c.source, c.raw)
+				if x != tc.want[i] {
+					t.Errorf("unwrap(%v, %d) = %d, want %d", c.source, c.raw, x, tc.want[i])
+				}
+				m.add(x, 1000*float64(i), math.NaN())
+			}
+			if m.resets != tc.wantResets {
+				t.Errorf("resets = %d, want %d", m.resets, tc.wantResets)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package serial
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+)
+
+// DoCommand implements movementsensor.MovementSensor. The command to run is
+// named by the "command" key of cmd.
+func (c *Compass) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
+	name, ok := cmd["command"].(string)
+	if !ok {
+		return nil, errors.New(`expected a "command" string`)
+	}
+	// the bias estimate runs for up to minutes and only holds c.mu while it
+	// talks to the device
+	if name == "estimate_gyro_bias" && c.replay == nil {
+		return c.gyroBiasCommand(ctx, cmd)
+	}
+	c.mu.Lock()
+	defer c.unlockAfterCommand()
+	if c.replay != nil {
+		return c.replayCommand(name, cmd)
+	}
+	// the stats cover the time the device was away too
+	if !c.connected && name != "stats" {
+		return nil, fmt.Errorf("device %s is disconnected: %w", c.cfg.DeviceID, ErrConnectionLost)
+	}
+	switch name {
+	case "export_settings":
+		return c.exportSettings(cmd)
+	case "apply_settings":
+		return c.applySettings(cmd)
+	case "factory_reset":
+		return c.factoryReset()
+	case "reboot":
+		return c.reboot()
+	case "reset_heading", "reset_inclination":
+		return c.resetOrientation(name, cmd)
+	case "restart_filter":
+		return c.restartFilter()
+	case "start_recording":
+		return c.recordingCommand(cmd)
+	case "stop_recording":
+		return c.stopRecording()
+	case "recording_status":
+		return c.recordingStatus(), nil
+	case "stats":
+		return c.packets.Stats(c.callback).toMap(), nil
+	case "diagnostics":
+		return c.diagnostics(cmd)
+	case "start_mag_calibration", "mag_calibration_status", "finish_mag_calibration", "cancel_mag_calibration",
+		"clear_mag_calibration":
+		return c.magCalibrationCommand(name, cmd)
+	default:
+		return nil, fmt.Errorf("unknown command %q", name)
+	}
+}
+
+// unlockAfterCommand releases c.mu after a command and then tells the other
+// users of the device about the device it came back as if the command reset
+// it. They are told only once c is done with the device, and without c.mu
+// held as one of them resetting the device at the same time would be
+// waiting to tell c in turn.
+func (c *Compass) unlockAfterCommand() {
+	device, port := c.resetTo, c.resetToPort
+	c.resetTo, c.resetToPort = nil, ""
+	c.mu.Unlock()
+	if device != nil {
+		devices.notifyAttached(c.handle, device, port, c)
+	}
+}
+
+// withConfigMode runs fn with the device in config mode and returns it to
+// measurement mode afterwards.
+func (c *Compass) withConfigMode(fn func() error) error {
+	if !c.device.GotoConfig() {
+		return deviceError(c.device, nil, "go to config mode")
+	}
+	err := fn()
+	if !c.device.GotoMeasurement() {
+		return multierr.Combine(err, deviceError(c.device, nil, "go to measurement mode"))
+	}
+	return err
+}
+
+func stringArg(cmd map[string]interface{}, key string) (string, error) {
+	v, ok := cmd[key].(string)
+	if !ok || v == "" {
+		return "", fmt.Errorf("expected a %q string", key)
+	}
+	return v, nil
+}
+
+// toMap converts v into the generic form DoCommand results are returned in.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// toList converts v into the generic form DoCommand results are returned in.
+func toList(v interface{}) ([]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var l []interface{}
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -18,6 +18,9 @@ import (
 	"go.viam.com/rdk/spatialmath"
 )
 
+// discoverTimeout bounds the scan for the configured device.
+const discoverTimeout = 30 * time.Second
+
 type Compass struct {
 	control   gen.XsControl
 	device    gen.XSDevice
@@ -26,73 +29,163 @@ type Compass struct {
 	closeCh   chan struct{}
 	closeOnce sync.Once
 	mu        sync.Mutex
+	cfg       Config
+	logger    golog.Logger
+
+	magneticDetector *MagneticDisturbanceDetector
+	magnetic         atomic.Value
+	triggers         atomic.Value
+	clock            *ClockMapper
+	packets          *packetTracker
+	status           atomic.Value
+	clipping         *clipDetector
+	sampleTime       atomic.Value
+	motion           atomic.Value
+	motionMu         sync.Mutex
+	magCal           atomic.Value
+	magCorrection    atomic.Value
+	correctedHeading atomic.Value
+	optionFlags      gen.XsDeviceOptionFlag
+	recorder         *recorder
+	// suspendedRecording is the recording stopped by an unplug, resumed once
+	// the device is back, and recordingEnded why a recording last ended
+	// without being stopped.
+	suspendedRecording *RecordingConfig
+	recordingEnded     string
+	replay             *replayer
+
+	// handle is the device shared through the device manager, and connected
+	// is false while it is unplugged.
+	handle     *deviceHandle
+	connected  bool
+	reconnects int64
+	// resetTo and resetToPort are what the device came back as after c
+	// reset it, for its other users to be told once c.mu is released.
+	resetTo     gen.XSDevice
+	resetToPort string
+	// link mirrors connected and reconnects for reading without c.mu.
+	link atomic.Value
 }
 
-func NewCompass(deviceID string, path string, baudRate int) (movementsensor.MovementSensor, error) {
-	control := gen.XsControlConstruct()
+// linkState is whether a Compass is connected and how often it reconnected.
+type linkState struct {
+	connected  bool
+	reconnects int64
+}
 
-	portInfoArray := gen.XSScannerScanPorts()
-	portInfoArrayPtr := gen.SwigcptrXsArrayXsPortInfo(portInfoArray.Swigcptr())
+// storeLink publishes connected and reconnects. It must be called with c.mu
+// held whenever they change.
+func (c *Compass) storeLink() {
+	c.link.Store(linkState{c.connected, c.reconnects})
+}
 
-	if portInfoArrayPtr.Size() == 0 {
-		return nil, errors.New("no mti device found")
-	}
-	mtPort := portInfoArrayPtr.First()
+// Config describes the device to connect to and how to set it up.
+type Config struct {
+	DeviceID string
+	// Name is the name of the component using the device, which labels its
+	// metrics.
+	Name string
+	// Path is the device's port and may be a symlink such as a
+	// /dev/serial/by-id name. When empty the device is found by DeviceID
+	// and, if set, its USB bus and address.
+	Path       string
+	USBBus     int
+	USBAddress int
+	BaudRate   int
 
-	foundPath := mtPort.PortName().ToStdString()
-	golog.Global().Infof("found device",
-		"id", mtPort.DeviceId().ToString().ToStdString(),
-		"port", foundPath,
-		"baudrate", mtPort.Baudrate(),
-	)
-	if foundPath != path {
-		return nil, fmt.Errorf("found device at %q but not %q", foundPath, path)
-	}
+	// WaitTimeout is how long to wait for the device to appear at startup.
+	// When zero, startup fails if the device is not already present.
+	WaitTimeout time.Duration
 
-	var useBaudRate gen.XsBaudRate
-	switch baudRate {
-	case 115200:
-		useBaudRate = gen.XBR_115k2
-	default:
-		return nil, fmt.Errorf("unknown baudrate %d", baudRate)
-	}
+	// SetOptionFlags and ClearOptionFlags are applied to the device's option
+	// flags before it goes to measurement mode.
+	SetOptionFlags   gen.XsDeviceOptionFlag
+	ClearOptionFlags gen.XsDeviceOptionFlag
 
-	pathStr := gen.NewXSString(path)
-	defer gen.DeleteXSString(pathStr)
-	if !control.OpenPort(pathStr, useBaudRate) {
-		defer control.Destruct()
-		return nil, errors.New("failed to open port")
-	}
+	// Settings are the desired device settings, which are compared to the
+	// device's stored settings on startup. Empty fields are not checked. Any
+	// drift is logged and, if WriteSettings is set, the differing settings are
+	// written to the device.
+	Settings      *DeviceSettings
+	WriteSettings bool
 
-	devID := gen.NewXSDeviceId()
-	defer gen.DeleteXSDeviceId(devID)
-	devIDStr := gen.NewXSString(deviceID)
-	defer gen.DeleteXSString(devIDStr)
-	devID.FromString(devIDStr)
+	// Recording is used by the "start_recording" command and, with
+	// RecordOnStartup, to record from startup on.
+	Recording       RecordingConfig
+	RecordOnStartup bool
+	// MetricsAddress, if set, is the address to serve Prometheus metrics at
+	// on /metrics. Components with the same address share the server.
+	MetricsAddress string
 
-	device := control.Device(devID)
-	if device.Swigcptr() == 0 {
-		defer control.Destruct()
-		return nil, errors.New("expected device")
-	}
+	// Logger is the logger of the component using the device, and defaults
+	// to golog.Global(). The SDK's own log output is logged to it from
+	// SDKLogLevel up, see SDKLogLevels.
+	Logger      golog.Logger
+	SDKLogLevel string
+	// SDKJournalFile, if set, is a file the SDK writes its journal to, for
+	// Xsens support.
+	SDKJournalFile string
 
-	device.SetDeviceOptionFlags(gen.XDOF_EnableContinuousZRU, gen.XDOF_None)
+	// MagCalibration, if set, corrects the magnetometer data for hard and
+	// soft iron effects. The compass heading stays the device's, and the
+	// heading computed from the corrected field and the device's inclination
+	// is read as "corrected_compass_heading".
+	MagCalibration *MagCalibration
 
-	callback := gen.NewCallbackHandler()
-	gen.AddCallbackHandler(callback, device)
+	// GyroBiasOnStartup, if set, is how long a gyroscope bias estimate runs
+	// for at startup, once the platform is still.
+	GyroBiasOnStartup time.Duration
+}
 
-	if !device.GotoMeasurement() {
-		return nil, errors.New("failed to go to measurement mode")
+func (cfg Config) logger() golog.Logger {
+	if cfg.Logger == nil {
+		return golog.Global()
+	}
+	return cfg.Logger
+}
+
+// NewCompass connects to the configured device and starts measuring. The
+// scan for the device, opening it and setting it up are given up on when ctx
+// is done.
+func NewCompass(ctx context.Context, cfg Config) (movementsensor.MovementSensor, error) {
+	var useBaudRate gen.XsBaudRate
+	switch cfg.BaudRate {
+	case 115200:
+		useBaudRate = gen.XBR_115k2
+	default:
+		return nil, fmt.Errorf("unknown baudrate %d: %w", cfg.BaudRate, ErrWrongBaudRate)
 	}
 
+	logger := cfg.logger()
+	if cfg.SDKJournalFile != "" {
+		if err := openSDKJournal(cfg.SDKJournalFile); err != nil {
+			return nil, fmt.Errorf("failed to open sdk journal: %w", err)
+		}
+	}
 	c := &Compass{
-		control:  control,
-		device:   device,
-		callback: callback,
+		cfg:              cfg,
+		logger:           logger,
+		callback:         gen.NewCallbackHandler(),
+		magneticDetector: NewMagneticDisturbanceDetector(MagneticDisturbanceConfig{}),
+		clock:            NewClockMapper(),
+		packets:          newPacketTracker(logger),
+		closeCh:          make(chan struct{}),
 	}
 	c.heading.Store(math.NaN())
+	c.magnetic.Store(MagneticDisturbance{Score: math.NaN()})
+	c.magCorrection.Store(cfg.MagCalibration)
+	c.correctedHeading.Store(math.NaN())
+	addJournalSink(c, logger, cfg.SDKLogLevel)
+	// c.mu is held until c is set up so the device manager cannot tell c
+	// about the device coming or going before then
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.connect(ctx, useBaudRate); err != nil {
+		logger.Errorw("failed to connect to device", "id", cfg.DeviceID, "port", c.cfg.Path, "error", err)
+		return nil, err
+	}
 
-	c.closeCh = make(chan struct{})
 	go func() {
 		ticker := time.NewTicker(time.Millisecond)
 		defer ticker.Stop()
@@ -103,46 +196,307 @@ func NewCompass(deviceID string, path string, baudRate int) (movementsensor.Move
 			case <-ticker.C:
 			}
 
-			if callback.PacketAvailable() {
-				packet := callback.GetNextPacket()
-				if packet.ContainsOrientation() {
-					euler := packet.OrientationEuler()
-					if yaw := euler.Yaw(); !math.IsNaN(yaw) {
-						c.heading.Store(yaw)
-					}
-				}
+			// the native buffer is drained every tick so it only drops
+			// packets when handling them falls behind
+			for c.callback.PacketAvailable() {
+				packet := c.callback.GetNextPacket()
+				c.handlePacket(packet)
+				gen.DeleteXSDataPacket(packet)
 			}
 
 		}
 	}()
+
+	if cfg.MetricsAddress != "" {
+		if err := startMetricsServer(cfg.MetricsAddress, logger); err != nil {
+			logger.Errorw("failed to serve metrics", "address", cfg.MetricsAddress, "error", err)
+			c.cfg.MetricsAddress = ""
+		}
+	}
+	metrics.register(c)
+
+	if cfg.RecordOnStartup && c.connected {
+		if err := c.startRecording(cfg.Recording); err != nil {
+			logger.Errorw("failed to start recording", "error", err)
+		}
+	}
+	if cfg.GyroBiasOnStartup > 0 && c.connected {
+		go c.gyroBiasOnStartup(cfg.GyroBiasOnStartup)
+	}
 	return c, nil
 }
 
+// connect finds the device, opens it unless another Compass already did,
+// and sets it up. On failure c is discarded, which for SDK calls given up on
+// when ctx is done happens once they return.
+func (c *Compass) connect(ctx context.Context, baudRate gen.XsBaudRate) error {
+	cfg := c.cfg
+	// a device already opened by another component is shared with it
+	control, handle, err := devices.lookup(ctx, cfg.DeviceID, c)
+	if err != nil {
+		c.discard(false)
+		return err
+	}
+	if handle == nil {
+		discover := cfg.discoverOptions()
+		found, err := findDevice(ctx, cfg, discover)
+		if err != nil {
+			// another component may have opened the device while it was
+			// looked for, which makes its port busy
+			var lookupErr error
+			if control, handle, lookupErr = devices.lookup(ctx, cfg.DeviceID, c); handle == nil {
+				if lookupErr != nil {
+					err = lookupErr
+				}
+				c.discard(false)
+				return err
+			}
+		}
+		if handle == nil {
+			c.logger.Infow("found device",
+				"id", found.DeviceID,
+				"port", found.Port,
+				"baudrate", found.BaudRate,
+				"family", found.Family,
+				"by_id", found.ByID,
+			)
+			control, handle, err = devices.open(ctx, cfg.DeviceID, found.Port, baudRate, discover, c)
+			if err != nil {
+				c.discard(false)
+				return err
+			}
+		}
+	}
+	c.control = control
+	c.handle = handle
+	c.device, c.cfg.Path, c.connected = devices.state(handle)
+	c.storeLink()
+
+	if !c.connected {
+		c.clipping = newClipDetector(nil)
+		c.logger.Warnw("device is disconnected, waiting for it to come back", "id", cfg.DeviceID)
+		return nil
+	}
+	c.clipping = newClipDetector(c.device)
+	err = callContext(ctx, "set up device", c.setup, func(err error) {
+		c.discard(err == nil)
+	})
+	if err != nil {
+		var ctxErr *contextError
+		if !errors.As(err, &ctxErr) {
+			c.discard(false)
+		}
+		return err
+	}
+	return nil
+}
+
+// discard undoes a failed connect, including the callback setup added when
+// it succeeded.
+func (c *Compass) discard(setUp bool) {
+	if setUp {
+		gen.RemoveCallbackHandler(c.callback, c.device)
+	}
+	if c.handle != nil {
+		devices.release(c.handle, c)
+	}
+	gen.DeleteCallbackHandler(c.callback)
+	removeJournalSink(c)
+}
+
+// findDevice scans for the configured device, waiting for it to appear if
+// cfg.WaitTimeout is set.
+func findDevice(ctx context.Context, cfg Config, discover DiscoverOptions) (DiscoveredDevice, error) {
+	if cfg.WaitTimeout > 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, cfg.WaitTimeout)
+		defer cancel()
+		found, err := WaitForDevice(waitCtx, discover)
+		if err != nil && ctx.Err() != nil {
+			return DiscoveredDevice{}, &contextError{"scan for devices", ctx.Err()}
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return DiscoveredDevice{}, fmt.Errorf("no mti device %s found at %q within %v: %w",
+				cfg.DeviceID, cfg.Path, cfg.WaitTimeout, ErrDeviceNotFound)
+		}
+		if err != nil {
+			return DiscoveredDevice{}, fmt.Errorf("failed to scan for devices: %w", err)
+		}
+		return found, nil
+	}
+	scanCtx, cancel := context.WithTimeout(ctx, discoverTimeout)
+	defer cancel()
+	found, err := Discover(scanCtx, discover)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return DiscoveredDevice{}, &contextError{"scan for devices", err}
+	}
+	if err != nil {
+		return DiscoveredDevice{}, fmt.Errorf("failed to scan for devices: %w", err)
+	}
+	if len(found) == 0 {
+		return DiscoveredDevice{}, fmt.Errorf("no mti device %s found at %q: %w", cfg.DeviceID, cfg.Path, ErrDeviceNotFound)
+	}
+	return found[0], nil
+}
+
+// setup checks the device's settings and option flags against the config
+// and starts measuring.
+func (c *Compass) setup() error {
+	cfg, device := c.cfg, c.device
+	c.logger.Infow("connected to device", deviceFields(device, cfg.Path)...)
+	if cfg.Settings != nil {
+		if err := checkSettingsDrift(c.logger, device, cfg.Settings, cfg.WriteSettings); err != nil {
+			return err
+		}
+	}
+
+	// option flags are only written when they differ to spare the device's
+	// flash
+	optionFlags := device.DeviceOptionFlags()
+	if optionFlags&cfg.SetOptionFlags != cfg.SetOptionFlags || optionFlags&cfg.ClearOptionFlags != 0 {
+		c.logger.Infow("writing device option flags",
+			"device", OptionFlagNames(optionFlags),
+			"set", OptionFlagNames(cfg.SetOptionFlags),
+			"clear", OptionFlagNames(cfg.ClearOptionFlags),
+		)
+		if !device.SetDeviceOptionFlags(cfg.SetOptionFlags, cfg.ClearOptionFlags) {
+			return deviceError(device, ErrConfigRejected, "set device option flags %v and clear %v",
+				OptionFlagNames(cfg.SetOptionFlags), OptionFlagNames(cfg.ClearOptionFlags))
+		}
+		optionFlags = device.DeviceOptionFlags()
+	}
+	if optionFlags&cfg.SetOptionFlags != cfg.SetOptionFlags || optionFlags&cfg.ClearOptionFlags != 0 {
+		c.logger.Warnw("device option flags differ from config",
+			"requested_set", OptionFlagNames(cfg.SetOptionFlags),
+			"requested_clear", OptionFlagNames(cfg.ClearOptionFlags),
+			"effective", OptionFlagNames(optionFlags),
+		)
+	} else {
+		c.logger.Infow("device option flags", "effective", OptionFlagNames(optionFlags))
+	}
+	c.optionFlags = optionFlags
+
+	gen.AddCallbackHandler(c.callback, device)
+	if !device.GotoMeasurement() {
+		err := deviceError(device, nil, "go to measurement mode")
+		gen.RemoveCallbackHandler(c.callback, device)
+		return err
+	}
+	c.logger.Infow("device measuring", "id", cfg.DeviceID, "port", cfg.Path)
+	return nil
+}
+
+func (c *Compass) handlePacket(packet gen.XSDataPacket) {
+	c.packets.update(packet, c.callback)
+	// replayed packets carry the times they were loaded at, so they are not
+	// mapped to host time
+	if c.clock != nil {
+		if sample, ok := c.clock.Update(packet); ok {
+			c.sampleTime.Store(sample)
+		}
+	}
+	status, ok := c.status.Load().(deviceStatus)
+	if !ok {
+		status.temperature = math.NaN()
+	}
+	if status, ok := updateStatus(status, packet); ok {
+		c.status.Store(status)
+	}
+	c.clipping.update(packet)
+	if monitor, _ := c.motion.Load().(*motionMonitor); monitor != nil {
+		monitor.update(packet)
+	}
+	if calibrator, _ := c.magCal.Load().(*magCalibrator); calibrator != nil && packet.ContainsCalibratedMagneticField() {
+		calibrator.update(vector3(packet.CalibratedMagneticField()))
+	}
+	// trigger indications can come in packets of their own
+	triggers, _ := c.triggers.Load().([]TriggerIndication)
+	if updated, ok := updateTriggerIndications(triggers, packet); ok {
+		c.triggers.Store(updated)
+	}
+	if !packet.ContainsOrientation() {
+		return
+	}
+	euler := packet.OrientationEuler()
+	defer gen.DeleteXSEuler(euler)
+	if yaw := euler.Yaw(); !math.IsNaN(yaw) {
+		c.heading.Store(yaw)
+	}
+	if !packet.ContainsCalibratedMagneticField() {
+		return
+	}
+	magnetometer := vector3(packet.CalibratedMagneticField())
+	if correction, _ := c.magCorrection.Load().(*MagCalibration); correction != nil {
+		// the device's heading comes from its own magnetometer calibration
+		// and is filtered, so the heading from the corrected field is kept
+		// apart from it rather than replacing it
+		magnetometer = correction.apply(magnetometer)
+		c.correctedHeading.Store(compassHeading(magneticYaw(magnetometer, euler.Roll(), euler.Pitch())))
+	}
+
+	// the disturbance detector needs the raw inertial data too, which is only
+	// present when enabled in the device's output configuration
+	if packet.ContainsCalibratedAcceleration() &&
+		packet.ContainsCalibratedGyroscopeData() &&
+		packet.ContainsSampleTimeFine() {
+		c.magnetic.Store(c.magneticDetector.Update(MagneticSample{
+			Time:          sampleTime(packet),
+			Magnetometer:  magnetometer,
+			Accelerometer: vector3(packet.CalibratedAcceleration()),
+			Gyroscope:     vector3(packet.CalibratedGyroscopeData()),
+			Roll:          euler.Roll(),
+			Pitch:         euler.Pitch(),
+		}))
+	}
+}
+
 func (c *Compass) CompassHeading(ctx context.Context, extra map[string]interface{}) (float64, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	// compass is set to 0 when facing north
+	// the yaw is 0 when facing north
 	// 180 when facing south
 	// 90 when facing west
 	// -90 when facing east
-	compass := c.heading.Load().(float64)
+	return compassHeading(c.heading.Load().(float64)), nil
+}
+
+// compassHeading converts a yaw in degrees to a compass heading.
+func compassHeading(yaw float64) float64 {
 	// sign flip & mod math is to ensure that
 	// the compass heading conforms to the
 	// motionservice.GetCompassHeading proto
 	// which expects:
 	// 0 is North, 90 is East, 180 is South, and 270 is   West
-	compass = math.Mod(-compass, 360)
+	compass := math.Mod(-yaw, 360)
 	compass = math.Mod(compass+360, 360)
-	return compass, nil
+	return compass
 }
 
 func (c *Compass) Close(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.closeOnce.Do(func() {
+		if c.recorder != nil {
+			if _, err := c.stopRecording(); err != nil {
+				c.logger.Warnw("failed to stop recording", "error", err)
+			}
+		}
 		close(c.closeCh)
-		defer c.control.Destruct()
+		// the journal is logged until the device is closed
+		defer removeJournalSink(c)
+		if c.replay != nil {
+			defer c.control.Destruct()
+			<-c.replay.exited
+			return
+		}
+		metrics.unregister(c)
+		if c.cfg.MetricsAddress != "" {
+			stopMetricsServer(c.cfg.MetricsAddress, c.logger)
+		}
 		defer gen.DeleteCallbackHandler(c.callback)
+		if c.connected {
+			gen.RemoveCallbackHandler(c.callback, c.device)
+		}
+		devices.release(c.handle, c)
 	})
 	return nil
 }
@@ -150,7 +504,18 @@ func (c *Compass) Close(ctx context.Context) error {
 func (c *Compass) Accuracy(ctx context.Context, extra map[string]interface{}) (map[string]float32, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	return nil, nil
+	magnetic := c.magnetic.Load().(MagneticDisturbance)
+	if math.IsNaN(magnetic.Score) {
+		return nil, nil
+	}
+	headingTrusted := float32(0)
+	if magnetic.HeadingTrusted {
+		headingTrusted = 1
+	}
+	return map[string]float32{
+		"magnetic_disturbance": float32(magnetic.Score),
+		"heading_trusted":      headingTrusted,
+	}, nil
 }
 
 // AngularVelocity unimplemented
@@ -202,14 +567,58 @@ func (c *Compass) Readings(ctx context.Context, extra map[string]interface{}) (m
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	readings := make(map[string]interface{})
+	readings["connected"] = c.connected
+	if c.suspendedRecording != nil {
+		readings["recording_suspended"] = true
+	}
+	for k, v := range c.clipping.readings() {
+		readings[k] = v
+	}
+	readings["device_option_flags"] = OptionFlagNames(c.optionFlags)
+	readings["packet_stats"] = c.packets.Stats(c.callback).toMap()
+	// magnetic disturbance is only known once the device outputs calibrated
+	// magnetometer, accelerometer and gyroscope data
+	if magnetic := c.magnetic.Load().(MagneticDisturbance); !math.IsNaN(magnetic.Score) {
+		readings["magnetic_disturbance"] = magnetic.Score
+		readings["heading_trusted"] = magnetic.HeadingTrusted
+	}
+	if correction, _ := c.magCorrection.Load().(*MagCalibration); correction != nil {
+		readings["mag_calibration"] = correction.toMap()
+		if heading := c.correctedHeading.Load().(float64); !math.IsNaN(heading) {
+			readings["corrected_compass_heading"] = heading
+		}
+	}
+	if triggers, _ := c.triggers.Load().([]TriggerIndication); triggers != nil {
+		readings["trigger_indications"] = triggerReadings(triggers)
+	}
+	if sample, ok := c.sampleTime.Load().(SampleTime); ok {
+		readings["sample_host_time"] = sample.Host.UTC().Format(time.RFC3339Nano)
+		readings["sample_host_monotonic_sec"] = sample.Monotonic.Seconds()
+		if sample.Fitted {
+			readings["sample_time_uncertainty_sec"] = sample.Uncertainty.Seconds()
+		}
+		if sample.Device != 0 {
+			readings["sample_device_time_sec"] = sample.Device.Seconds()
+		}
+	}
+	if c.clock == nil {
+		return readings, nil
+	}
+	if fit, ok := c.clock.Fit(); ok {
+		readings["clock"] = map[string]interface{}{
+			"source":       fit.Source,
+			"offset":       fit.Offset.UTC().Format(time.RFC3339Nano),
+			"period_sec":   fit.Period.Seconds(),
+			"drift_ppm":    fit.DriftPPM,
+			"residual_sec": fit.Residual.Seconds(),
+			"samples":      fit.Samples,
+			"outliers":     fit.Outliers,
+			"resets":       fit.Resets,
+		}
+	}
 	return readings, nil
 }
 
-// DoCommand implements movementsensor.MovementSensor.
-func (*Compass) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
-	return nil, nil
-}
-
 // Name implements movementsensor.MovementSensor.
 func (*Compass) Name() resource.Name {
 	panic("unimplemented")
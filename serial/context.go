@@ -0,0 +1,51 @@
+package serial
+
+import (
+	"context"
+	"errors"
+)
+
+// contextError is an operation given up on because its context was done.
+type contextError struct {
+	op  string
+	err error
+}
+
+func (e *contextError) Error() string {
+	return "failed to " + e.op + ": " + e.err.Error()
+}
+
+func (e *contextError) Unwrap() error {
+	return e.err
+}
+
+// Is classifies a missed deadline as ErrTimeout.
+func (e *contextError) Is(target error) bool {
+	return target == ErrTimeout && errors.Is(e.err, context.DeadlineExceeded)
+}
+
+// callContext runs fn, which may block in the SDK, until it returns or ctx
+// is done. SDK calls cannot be interrupted, so when ctx is done first fn
+// keeps running and abandoned is called with its error once it returns, to
+// undo whatever fn did. When ctx is done before fn starts, fn is not run and
+// abandoned is called with ctx's error. Either way the returned error is a
+// *contextError.
+func callContext(ctx context.Context, op string, fn func() error, abandoned func(err error)) error {
+	if err := ctx.Err(); err != nil {
+		abandoned(err)
+		return &contextError{op, err}
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		go func() {
+			abandoned(<-done)
+		}()
+		return &contextError{op, ctx.Err()}
+	}
+}
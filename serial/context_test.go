@@ -0,0 +1,113 @@
+package serial
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCallContext(t *testing.T) {
+	errFn := errors.New("fn failed")
+	for _, tc := range []struct {
+		name string
+		// ctx returns the context to call with and a function run once fn
+		// has started, e.g. to cancel the context
+		ctx           func() (context.Context, func())
+		fnErr         error
+		wantErr       error
+		wantRun       bool
+		wantAbandoned error
+		wantTimeout   bool
+	}{
+		{
+			name:    "fn returns first",
+			ctx:     func() (context.Context, func()) { return context.Background(), func() {} },
+			fnErr:   errFn,
+			wantErr: errFn,
+			wantRun: true,
+		},
+		{
+			name: "done before fn starts",
+			ctx: func() (context.Context, func()) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, func() {}
+			},
+			wantErr:       context.Canceled,
+			wantAbandoned: context.Canceled,
+		},
+		{
+			name: "cancelled while fn runs",
+			ctx: func() (context.Context, func()) {
+				ctx, cancel := context.WithCancel(context.Background())
+				return ctx, cancel
+			},
+			fnErr:         errFn,
+			wantErr:       context.Canceled,
+			wantRun:       true,
+			wantAbandoned: errFn,
+		},
+		{
+			name: "deadline while fn runs",
+			ctx: func() (context.Context, func()) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				return ctx, func() {
+					<-ctx.Done()
+					cancel()
+				}
+			},
+			wantErr:     context.DeadlineExceeded,
+			wantRun:     true,
+			wantTimeout: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, started := tc.ctx()
+			release := make(chan struct{})
+			ran := false
+			fn := func() error {
+				ran = true
+				started()
+				if ctx.Err() == nil {
+					return tc.fnErr
+				}
+				<-release
+				return tc.fnErr
+			}
+			abandoned := make(chan error, 1)
+			err := callContext(ctx, "test", fn, func(err error) {
+				abandoned <- err
+			})
+			close(release)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("err = %v, want %v", err, tc.wantErr)
+			}
+			if errors.Is(err, ErrTimeout) != tc.wantTimeout {
+				t.Errorf("errors.Is(%v, ErrTimeout) = %v, want %v", err, !tc.wantTimeout, tc.wantTimeout)
+			}
+			if ctx.Err() == nil {
+				if len(abandoned) != 0 {
+					t.Errorf("abandoned with %v, want fn's result returned", <-abandoned)
+				}
+				return
+			}
+			var ctxErr *contextError
+			if !errors.As(err, &ctxErr) {
+				t.Errorf("err is a %T, want a *contextError", err)
+			}
+			select {
+			case got := <-abandoned:
+				if got != tc.wantAbandoned {
+					t.Errorf("abandoned with %v, want %v", got, tc.wantAbandoned)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("abandoned was not called")
+			}
+			if ran != tc.wantRun {
+				t.Errorf("fn ran = %v, want %v", ran, tc.wantRun)
+			}
+		})
+	}
+}
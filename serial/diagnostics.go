@@ -0,0 +1,180 @@
+package serial
+
+import (
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// XsStatusFlag bits of a packet's status word. The bits from 8 on are only
+// in the detailed status.
+var (
+	statusSelfTestOK          = uint32(gen.XSF_SelfTestOk)
+	statusOrientationValid    = uint32(gen.XSF_OrientationValid)
+	statusGnssFix             = uint32(gen.XSF_GpsValid)
+	statusNoRotationMask      = uint32(gen.XSF_NoRotationMask)
+	statusNoRotationAborted   = uint32(gen.XSF_NoRotationAborted)
+	statusNoRotationRejected  = uint32(gen.XSF_NoRotationSamplesRejected)
+	statusRepresentative      = uint32(gen.XSF_RepresentativeMotion)
+	statusExternalClockSynced = uint32(gen.XSF_ExternalClockSynced)
+	statusClipAccX            = uint32(gen.XSF_ClipAccX)
+	statusClipGyrX            = uint32(gen.XSF_ClipGyrX)
+	statusClipMagX            = uint32(gen.XSF_ClipMagX)
+	statusClipMask            = uint32(gen.XSF_ClipAccX | gen.XSF_ClipAccY | gen.XSF_ClipAccZ |
+		gen.XSF_ClipGyrX | gen.XSF_ClipGyrY | gen.XSF_ClipGyrZ |
+		gen.XSF_ClipMagX | gen.XSF_ClipMagY | gen.XSF_ClipMagZ)
+	statusRetransmitted    = uint32(gen.XSF_Retransmitted)
+	statusClippingDetected = uint32(gen.XSF_ClippingDetected)
+	statusInterpolated     = uint32(gen.XSF_Interpolated)
+	statusSyncIn           = uint32(gen.XSF_SyncIn)
+	statusSyncOut          = uint32(gen.XSF_SyncOut)
+	statusFilterModeMask   = uint32(gen.XSF_FilterMode)
+	statusFilterModeShift  = bits.TrailingZeros32(statusFilterModeMask)
+	statusGnssTimePulse    = uint32(gen.XSF_HaveGnssTimePulse)
+	statusRtkMask          = uint32(gen.XSF_RtkStatus)
+	statusRtkShift         = bits.TrailingZeros32(statusRtkMask)
+)
+
+// XsSelfTestFlag bits: one per axis of each inertial sensor, then the
+// other components.
+var selfTestComponents = []string{"baro", "gnss", "battery", "flash", "button"}
+
+// deviceStatus is the status and temperature of the latest packet that had
+// them.
+type deviceStatus struct {
+	hasStatus   bool
+	status      uint32
+	detailed    bool
+	temperature float64
+	updated     time.Time
+}
+
+// updateStatus returns status updated with the status word and temperature
+// of packet.
+func updateStatus(status deviceStatus, packet gen.XSDataPacket) (deviceStatus, bool) {
+	changed := false
+	if packet.ContainsStatus() {
+		status.status = uint32(packet.Status())
+		status.detailed = packet.ContainsDetailedStatus()
+		status.hasStatus = true
+		changed = true
+	}
+	if packet.ContainsTemperature() {
+		status.temperature = packet.Temperature()
+		changed = true
+	}
+	if changed {
+		status.updated = time.Now()
+	}
+	return status, changed
+}
+
+// axisFlags maps three consecutive bits from first on to x, y and z.
+func axisFlags(bits, first uint32) map[string]interface{} {
+	return map[string]interface{}{
+		"x": bits&first != 0,
+		"y": bits&(first<<1) != 0,
+		"z": bits&(first<<2) != 0,
+	}
+}
+
+// decodeStatus decodes a status word. Without detailed set only its low
+// byte is known.
+func decodeStatus(status uint32, detailed bool) map[string]interface{} {
+	noRotation := "off"
+	switch status & statusNoRotationMask {
+	case statusNoRotationMask:
+		noRotation = "running"
+	case statusNoRotationAborted:
+		noRotation = "aborted"
+	case statusNoRotationRejected:
+		noRotation = "samples_rejected"
+	}
+	decoded := map[string]interface{}{
+		"word":                  status,
+		"self_test_ok":          status&statusSelfTestOK != 0,
+		"filter_valid":          status&statusOrientationValid != 0,
+		"gnss_fix":              status&statusGnssFix != 0,
+		"no_rotation_update":    noRotation,
+		"representative_motion": status&statusRepresentative != 0,
+		"external_clock_synced": status&statusExternalClockSynced != 0,
+	}
+	if !detailed {
+		return decoded
+	}
+	decoded["clipping"] = map[string]interface{}{
+		"accelerometer": axisFlags(status, statusClipAccX),
+		"gyroscope":     axisFlags(status, statusClipGyrX),
+		"magnetometer":  axisFlags(status, statusClipMagX),
+	}
+	decoded["clipping_detected"] = status&statusClippingDetected != 0
+	decoded["retransmitted"] = status&statusRetransmitted != 0
+	decoded["interpolated"] = status&statusInterpolated != 0
+	decoded["sync_in"] = status&statusSyncIn != 0
+	decoded["sync_out"] = status&statusSyncOut != 0
+	decoded["filter_mode"] = (status & statusFilterModeMask) >> statusFilterModeShift
+	decoded["gnss_time_pulse"] = status&statusGnssTimePulse != 0
+	decoded["rtk"] = []string{"none", "float", "fixed", "invalid"}[(status&statusRtkMask)>>statusRtkShift]
+	return decoded
+}
+
+// decodeSelfTest decodes XsSelfTestResult flags, which are set for the tests
+// that passed.
+func decodeSelfTest(flags int) map[string]interface{} {
+	result := map[string]interface{}{
+		"flags":         flags,
+		"accelerometer": axisFlags(uint32(flags), 1),
+		"gyroscope":     axisFlags(uint32(flags), 1<<3),
+		"magnetometer":  axisFlags(uint32(flags), 1<<6),
+	}
+	for i, name := range selfTestComponents {
+		result[name] = flags&(1<<(9+i)) != 0
+	}
+	return result
+}
+
+// diagnostics implements the "diagnostics" command. The self test needs the
+// device out of measurement mode, so it only runs when the device is not
+// measuring unless "self_test" is set, which stops measuring while it runs.
+func (c *Compass) diagnostics(cmd map[string]interface{}) (map[string]interface{}, error) {
+	id := c.device.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	result := map[string]interface{}{
+		"device_id":         deviceIDString(id),
+		"measuring":         c.device.IsMeasuring(),
+		"packet_error_rate": c.device.PacketErrorRate(),
+	}
+
+	selfTest, _ := cmd["self_test"].(bool)
+	switch {
+	case !c.device.IsMeasuring():
+		result["self_test"] = decodeSelfTest(gen.RunSelfTest(c.device))
+	case selfTest:
+		var flags int
+		if err := c.withConfigMode(func() error {
+			flags = gen.RunSelfTest(c.device)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		result["self_test"] = decodeSelfTest(flags)
+	default:
+		result["self_test"] = "skipped while measuring"
+	}
+	// the last result is read after the self test so it reports on it
+	result["last_result"] = resultCode(c.device.LastResult())
+	result["last_result_text"] = goString(c.device.LastResultText())
+
+	if status, ok := c.status.Load().(deviceStatus); ok {
+		if status.hasStatus {
+			result["status"] = decodeStatus(status.status, status.detailed)
+		}
+		if !math.IsNaN(status.temperature) {
+			result["temperature_c"] = status.temperature
+		}
+		result["status_age_sec"] = time.Since(status.updated).Seconds()
+	}
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package serial
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		status   uint32
+		detailed bool
+		want     map[string]interface{}
+	}{
+		{
+			name:   "low byte",
+			status: statusSelfTestOK | statusOrientationValid | statusNoRotationMask | statusExternalClockSynced,
+			want: map[string]interface{}{
+				"word":                  uint32(0x5b),
+				"self_test_ok":          true,
+				"filter_valid":          true,
+				"gnss_fix":              false,
+				"no_rotation_update":    "running",
+				"representative_motion": false,
+				"external_clock_synced": true,
+			},
+		},
+		{
+			name:   "detailed bits ignored without the detailed status",
+			status: statusGnssFix | statusNoRotationAborted | statusClipAccX | statusSyncIn,
+			want: map[string]interface{}{
+				"word":                  uint32(0x00200114),
+				"self_test_ok":          false,
+				"filter_valid":          false,
+				"gnss_fix":              true,
+				"no_rotation_update":    "aborted",
+				"representative_motion": false,
+				"external_clock_synced": false,
+			},
+		},
+		{
+			name: "detailed",
+			status: statusNoRotationRejected | statusRepresentative | statusClipAccX<<2 | statusClipGyrX |
+				statusClipMagX<<1 | statusClippingDetected | statusRetransmitted | statusInterpolated |
+				statusSyncOut | 3<<statusFilterModeShift | statusGnssTimePulse | 2<<statusRtkShift,
+			detailed: true,
+			want: map[string]interface{}{
+				"word":                  uint32(0x15dc8c28),
+				"self_test_ok":          false,
+				"filter_valid":          false,
+				"gnss_fix":              false,
+				"no_rotation_update":    "samples_rejected",
+				"representative_motion": true,
+				"external_clock_synced": false,
+				"clipping": map[string]interface{}{
+					"accelerometer": map[string]interface{}{"x": false, "y": false, "z": true},
+					"gyroscope":     map[string]interface{}{"x": true, "y": false, "z": false},
+					"magnetometer":  map[string]interface{}{"x": false, "y": true, "z": false},
+				},
+				"clipping_detected": true,
+				"retransmitted":     true,
+				"interpolated":      true,
+				"sync_in":           false,
+				"sync_out":          true,
+				"filter_mode":       uint32(3),
+				"gnss_time_pulse":   true,
+				"rtk":               "fixed",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := decodeStatus(tc.status, tc.detailed); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("decodeStatus(%#x) = %v, want %v", tc.status, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSelfTest(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		flags int
+		want  map[string]interface{}
+	}{
+		{
+			name: "failed",
+			want: map[string]interface{}{
+				"flags":         0,
+				"accelerometer": map[string]interface{}{"x": false, "y": false, "z": false},
+				"gyroscope":     map[string]interface{}{"x": false, "y": false, "z": false},
+				"magnetometer":  map[string]interface{}{"x": false, "y": false, "z": false},
+				"baro":          false,
+				"gnss":          false,
+				"battery":       false,
+				"flash":         false,
+				"button":        false,
+			},
+		},
+		{
+			name:  "inertial sensors and flash passed",
+			flags: 0x1ff | 1<<12,
+			want: map[string]interface{}{
+				"flags":         0x11ff,
+				"accelerometer": map[string]interface{}{"x": true, "y": true, "z": true},
+				"gyroscope":     map[string]interface{}{"x": true, "y": true, "z": true},
+				"magnetometer":  map[string]interface{}{"x": true, "y": true, "z": true},
+				"baro":          false,
+				"gnss":          false,
+				"battery":       false,
+				"flash":         true,
+				"button":        false,
+			},
+		},
+		{
+			name:  "one axis",
+			flags: 1 << 4,
+			want: map[string]interface{}{
+				"flags":         0x10,
+				"accelerometer": map[string]interface{}{"x": false, "y": false, "z": false},
+				"gyroscope":     map[string]interface{}{"x": false, "y": true, "z": false},
+				"magnetometer":  map[string]interface{}{"x": false, "y": false, "z": false},
+				"baro":          false,
+				"gnss":          false,
+				"battery":       false,
+				"flash":         false,
+				"button":        false,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := decodeSelfTest(tc.flags); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("decodeSelfTest(%#x) = %v, want %v", tc.flags, got, tc.want)
+			}
+		})
+	}
+}
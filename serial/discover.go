@@ -0,0 +1,182 @@
+package serial
+
+import (
+	"context"
+	"strings"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// scanPortTimeout is how long, in milliseconds, the scanner waits for a
+// device to answer on one port and baud rate.
+const scanPortTimeout = 100
+
+// DiscoverOptions filter the devices returned by Discover.
+type DiscoverOptions struct {
+	// DeviceID and Port only return the device with this serial number or on
+	// this port. Port may be a symlink such as a /dev/serial/by-id name.
+	DeviceID string
+	Port     string
+	// USBBus and USBAddress, when not zero, only return the device at this
+	// position on the USB bus.
+	USBBus     int
+	USBAddress int
+	// Family only returns devices of this family, see DiscoveredDevice.
+	Family string
+	// USBOnly skips devices on plain serial ports.
+	USBOnly bool
+	// BaudRate only scans at this baud rate instead of all of them.
+	BaudRate int
+	// Identify opens every device found to read its product code and
+	// versions. This leaves the devices in config mode.
+	Identify bool
+
+	// Logger logs the scan, and defaults to golog.Global(). The SDK's scan log
+	// is logged to it at SDKLogLevel, see SDKLogLevels.
+	Logger      golog.Logger
+	SDKLogLevel string
+}
+
+func (opts DiscoverOptions) logger() golog.Logger {
+	if opts.Logger == nil {
+		return golog.Global()
+	}
+	return opts.Logger
+}
+
+// DiscoveredDevice is a device found by Discover.
+type DiscoveredDevice struct {
+	Port       string `json:"port"`
+	USB        bool   `json:"usb"`
+	USBBus     int    `json:"usb_bus,omitempty"`
+	USBAddress int    `json:"usb_address,omitempty"`
+	BaudRate   int    `json:"baud_rate"`
+	DeviceID   string `json:"device_id"`
+	// ByID is the stable /dev/serial/by-id link to Port, if udev made one.
+	ByID string `json:"by_id,omitempty"`
+	// Family is the device family as named by the SDK, e.g. "MTi-6x0" or
+	// "MTi-G-x10".
+	Family string `json:"family"`
+
+	// ProductCode, FirmwareVersion and HardwareVersion are only set with
+	// DiscoverOptions.Identify.
+	ProductCode     string `json:"product_code,omitempty"`
+	FirmwareVersion string `json:"firmware_version,omitempty"`
+	HardwareVersion string `json:"hardware_version,omitempty"`
+}
+
+// deviceFamily names the family of an MTi device ID.
+func deviceFamily(id gen.XSDeviceId) string {
+	switch {
+	case id.IsMtiX():
+		return "MTi-x"
+	case id.IsMtiX0():
+		return "MTi-x0"
+	case id.IsMtigX00():
+		return "MTi-G-x00"
+	case id.IsMtigX10():
+		return "MTi-G-x10"
+	case id.IsMtiX00():
+		return "MTi-x00"
+	case id.IsMti3X0():
+		return "MTi-3x0"
+	case id.IsMti6X0():
+		return "MTi-6x0"
+	case id.IsMti8X0():
+		return "MTi-8x0"
+	default:
+		return "unknown"
+	}
+}
+
+// Discover scans all serial and USB ports for Xsens devices. The scan is
+// aborted when ctx is done.
+func Discover(ctx context.Context, opts DiscoverOptions) ([]DiscoveredDevice, error) {
+	port := resolvePort(opts.Port)
+	rate := gen.XBR_Invalid
+	if opts.BaudRate != 0 {
+		rate = gen.NumericToBaudRate(opts.BaudRate)
+	}
+
+	logger := opts.logger()
+	scanMu.Lock()
+	defer scanMu.Unlock()
+	if log := sdkLogFunc(logger, opts.SDKLogLevel); log != nil {
+		gen.SetScanLogHandler(log)
+		defer gen.SetScanLogHandler(nil)
+	}
+
+	scanned := make(chan gen.XsPortInfoArray, 1)
+	go func() {
+		scanned <- gen.XSScannerScanPorts__SWIG_2(rate, scanPortTimeout)
+	}()
+	var array gen.SwigcptrXsArrayXsPortInfo
+	select {
+	case ports := <-scanned:
+		array = gen.SwigcptrXsArrayXsPortInfo(ports.Swigcptr())
+	case <-ctx.Done():
+		gen.XSScannerAbortScan()
+		gen.DeleteXsArrayXsPortInfo(gen.SwigcptrXsArrayXsPortInfo((<-scanned).Swigcptr()))
+		return nil, ctx.Err()
+	}
+	defer gen.DeleteXsArrayXsPortInfo(array)
+
+	var control gen.XsControl
+	if opts.Identify {
+		control = gen.XsControlConstruct()
+		defer control.Destruct()
+	}
+
+	devices := []DiscoveredDevice{}
+	for i := int64(0); i < array.Size(); i++ {
+		info := array.At__SWIG_1(i)
+		id := info.DeviceId()
+		device := DiscoveredDevice{
+			Port:     info.PortName_c_str(),
+			USB:      info.IsUsb(),
+			BaudRate: gen.BaudRateToNumeric(info.Baudrate()),
+			DeviceID: deviceIDString(id),
+			Family:   deviceFamily(id),
+		}
+		gen.DeleteXSDeviceId(id)
+		if device.USB {
+			device.USBBus, device.USBAddress = info.UsbBus(), info.UsbAddress()
+		}
+
+		if (opts.DeviceID != "" && !strings.EqualFold(device.DeviceID, opts.DeviceID)) ||
+			(port != "" && device.Port != port) ||
+			(opts.USBBus != 0 && device.USBBus != opts.USBBus) ||
+			(opts.USBAddress != 0 && device.USBAddress != opts.USBAddress) ||
+			(opts.Family != "" && !strings.EqualFold(device.Family, opts.Family)) ||
+			(opts.USBOnly && !device.USB) {
+			continue
+		}
+		device.ByID = serialByID(device.Port)
+		if opts.Identify {
+			identify(logger, control, info, &device)
+		}
+		devices = append(devices, device)
+		if ctx.Err() != nil {
+			return devices, ctx.Err()
+		}
+	}
+	return devices, nil
+}
+
+// identify opens a discovered device to read its product code and versions.
+func identify(logger golog.Logger, control gen.XsControl, info gen.XSPortInfo, device *DiscoveredDevice) {
+	if !control.OpenPort(info) {
+		logger.Debugw("failed to open device to identify it", "port", device.Port)
+		return
+	}
+	id := info.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	dev := control.Device(id)
+	if dev.Swigcptr() != 0 {
+		device.ProductCode = strings.TrimSpace(goString(dev.ProductCode()))
+		device.FirmwareVersion = versionString(dev.FirmwareVersion())
+		device.HardwareVersion = versionString(dev.HardwareVersion())
+	}
+	control.ClosePort(info)
+}
@@ -0,0 +1,130 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+	"golang.org/x/sys/unix"
+)
+
+// Errors that failures are classified as, for errors.Is.
+var (
+	// ErrDeviceNotFound is returned when the device or its port is not there.
+	ErrDeviceNotFound = errors.New("device not found")
+	// ErrPortBusy is returned when another process holds the port.
+	ErrPortBusy = errors.New("port busy")
+	// ErrPermissionDenied is returned when the port cannot be opened for
+	// reading and writing, e.g. without membership of the dialout group.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrWrongBaudRate is returned for a baud rate the device or the library
+	// does not support.
+	ErrWrongBaudRate = errors.New("wrong baud rate")
+	// ErrConfigRejected is returned when the device refuses a setting.
+	ErrConfigRejected = errors.New("config rejected")
+	// ErrTimeout is returned when the device does not answer in time.
+	ErrTimeout = errors.New("timeout")
+	// ErrConnectionLost is returned when the device went away.
+	ErrConnectionLost = errors.New("connection lost")
+)
+
+// ResultError is a failed SDK call with the XsResultValue the SDK reported
+// for it.
+type ResultError struct {
+	// Op is what failed, e.g. "go to measurement mode".
+	Op string
+	// Result is the XsResultValue and Text is the SDK's description of it.
+	Result int
+	Text   string
+	// Kind is the error the result is classified as, or nil.
+	Kind error
+}
+
+func (e *ResultError) Error() string {
+	msg := "failed to " + e.Op
+	if e.Text != "" {
+		msg += ": " + e.Text
+	}
+	if e.Result != gen.XRV_OK {
+		msg += fmt.Sprintf(" (XsResultValue %d)", e.Result)
+	}
+	return msg
+}
+
+// Unwrap returns the error e is classified as.
+func (e *ResultError) Unwrap() error {
+	return e.Kind
+}
+
+// Retryable reports whether err may go away when retried, e.g. once the
+// device is plugged back in or another process lets go of its port.
+func Retryable(err error) bool {
+	return errors.Is(err, ErrDeviceNotFound) ||
+		errors.Is(err, ErrPortBusy) ||
+		errors.Is(err, ErrTimeout) ||
+		errors.Is(err, ErrConnectionLost)
+}
+
+// resultKind classifies an XsResultValue.
+func resultKind(result int) error {
+	switch result {
+	case gen.XRV_NOTFOUND, gen.XRV_INVALIDID, gen.XRV_PORTNOTFOUND:
+		return ErrDeviceNotFound
+	case gen.XRV_ALREADYOPEN, gen.XRV_BUSY, gen.XRV_IN_USE:
+		return ErrPortBusy
+	case gen.XRV_ACCESSDENIED:
+		return ErrPermissionDenied
+	case gen.XRV_BAUDRATEINVALID:
+		return ErrWrongBaudRate
+	case gen.XRV_INVALIDPARAM, gen.XRV_INVALIDFILTERPROFILE, gen.XRV_OUTPUTCONFIGERROR,
+		gen.XRV_CONFIGCHECKFAIL, gen.XRV_UNSUPPORTED:
+		return ErrConfigRejected
+	case gen.XRV_TIMEOUT, gen.XRV_TIMEOUTNODATA:
+		return ErrTimeout
+	case gen.XRV_UNEXPECTED_DISCONNECT, gen.XRV_EXPECTED_DISCONNECT, gen.XRV_NOPORTOPEN, gen.XRV_NOFILEORPORTOPEN:
+		return ErrConnectionLost
+	default:
+		return nil
+	}
+}
+
+func newResultError(op string, result int, text string, kind error) *ResultError {
+	if classified := resultKind(result); classified != nil {
+		kind = classified
+	}
+	return &ResultError{Op: op, Result: result, Text: text, Kind: kind}
+}
+
+// deviceError returns the error of op failing on device, classified by the
+// device's last result or else as kind.
+func deviceError(device gen.XSDevice, kind error, format string, args ...interface{}) *ResultError {
+	return newResultError(fmt.Sprintf(format, args...),
+		resultCode(device.LastResult()), goString(device.LastResultText()), kind)
+}
+
+// controlError returns the error of op failing on control, classified by
+// its last result or else as kind.
+func controlError(control gen.XsControl, kind error, format string, args ...interface{}) *ResultError {
+	return newResultError(fmt.Sprintf(format, args...),
+		resultCode(control.LastResult()), goString(control.LastResultText()), kind)
+}
+
+// portError returns the error of control failing to open the port at path.
+// The SDK reports the same result whether the port is missing, not
+// accessible or locked by another process, so the port is checked to tell.
+func portError(control gen.XsControl, path string) error {
+	err := controlError(control, nil, "open port %q", path)
+	if err.Result != gen.XRV_INPUTCANNOTBEOPENED {
+		return err
+	}
+	switch _, statErr := os.Stat(path); {
+	case os.IsNotExist(statErr):
+		err.Kind = ErrDeviceNotFound
+	case unix.Access(path, unix.R_OK|unix.W_OK) != nil:
+		err.Kind = ErrPermissionDenied
+	default:
+		err.Kind = ErrPortBusy
+	}
+	return err
+}
@@ -0,0 +1,91 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+func TestResultKind(t *testing.T) {
+	for _, tc := range []struct {
+		result int
+		want   error
+	}{
+		{gen.XRV_OK, nil},
+		{gen.XRV_NOTFOUND, ErrDeviceNotFound},
+		{gen.XRV_PORTNOTFOUND, ErrDeviceNotFound},
+		{gen.XRV_BUSY, ErrPortBusy},
+		{gen.XRV_IN_USE, ErrPortBusy},
+		{gen.XRV_ACCESSDENIED, ErrPermissionDenied},
+		{gen.XRV_BAUDRATEINVALID, ErrWrongBaudRate},
+		{gen.XRV_OUTPUTCONFIGERROR, ErrConfigRejected},
+		{gen.XRV_UNSUPPORTED, ErrConfigRejected},
+		{gen.XRV_TIMEOUTNODATA, ErrTimeout},
+		{gen.XRV_UNEXPECTED_DISCONNECT, ErrConnectionLost},
+		{gen.XRV_NOPORTOPEN, ErrConnectionLost},
+		{gen.XRV_INPUTCANNOTBEOPENED, nil},
+	} {
+		t.Run(fmt.Sprint(tc.result), func(t *testing.T) {
+			if got := resultKind(tc.result); got != tc.want {
+				t.Errorf("resultKind(%d) = %v, want %v", tc.result, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResultError(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		err           error
+		wantMsg       string
+		wantKind      error
+		wantRetryable bool
+	}{
+		{
+			name:          "classified by result",
+			err:           newResultError("go to measurement mode", gen.XRV_TIMEOUT, "timeout occurred", ErrConfigRejected),
+			wantMsg:       "failed to go to measurement mode: timeout occurred (XsResultValue 258)",
+			wantKind:      ErrTimeout,
+			wantRetryable: true,
+		},
+		{
+			name:     "classified by the caller",
+			err:      newResultError("set the filter profile", gen.XRV_OK, "", ErrConfigRejected),
+			wantMsg:  "failed to set the filter profile",
+			wantKind: ErrConfigRejected,
+		},
+		{
+			name:          "wrapped",
+			err:           fmt.Errorf("reattach: %w", newResultError("open port", gen.XRV_BUSY, "", nil)),
+			wantMsg:       "reattach: failed to open port (XsResultValue 276)",
+			wantKind:      ErrPortBusy,
+			wantRetryable: true,
+		},
+		{
+			name:    "unclassified",
+			err:     newResultError("read the device id", gen.XRV_INPUTCANNOTBEOPENED, "", nil),
+			wantMsg: "failed to read the device id (XsResultValue 267)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tc.wantMsg)
+			}
+			var resultErr *ResultError
+			if !errors.As(tc.err, &resultErr) {
+				t.Fatal("not a ResultError")
+			}
+			if resultErr.Kind != tc.wantKind {
+				t.Errorf("Kind = %v, want %v", resultErr.Kind, tc.wantKind)
+			}
+			if tc.wantKind != nil && !errors.Is(tc.err, tc.wantKind) {
+				t.Errorf("errors.Is(%v) = false", tc.wantKind)
+			}
+			if got := Retryable(tc.err); got != tc.wantRetryable {
+				t.Errorf("Retryable = %v, want %v", got, tc.wantRetryable)
+			}
+		})
+	}
+}
@@ -0,0 +1,457 @@
+package serial
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// Export formats.
+const (
+	ExportCSV      = "csv"
+	ExportJSONL    = "jsonl"
+	ExportColumnar = "columnar"
+	// ExportTable is a fixed width text table for terminals.
+	ExportTable = "table"
+)
+
+// columnarMagic starts every file in the columnar format.
+const columnarMagic = "XSCOL1\n"
+
+// columnarBlockRows is the number of rows buffered per block of the columnar
+// format.
+const columnarBlockRows = 4096
+
+// exportField is a named group of columns taken from a packet. extract
+// fills its columns of row and leaves them NaN when the packet lacks the
+// data. state is what it keeps across the packets of one export.
+type exportField struct {
+	name    string
+	columns []string
+	extract func(packet gen.XSDataPacket, row []float64, state *exportState)
+}
+
+// exportState is what fields keep across the packets of one export.
+type exportState struct {
+	sampleTime sampleTimeUnwrapper
+}
+
+// exportFields are all fields that can be exported, in column order. Angles
+// are in degrees, angular rates in rad/s, accelerations in m/s^2, times in
+// seconds and the magnetic field in arbitrary units normalized to the
+// earth's field during calibration.
+var exportFields = []exportField{
+	{"packet_counter", []string{"packet_counter"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsPacketCounter() {
+			row[0] = float64(p.PacketCounter())
+		}
+	}},
+	{"sample_time", []string{"sample_time_s"}, func(p gen.XSDataPacket, row []float64, state *exportState) {
+		if p.ContainsSampleTimeFine() {
+			row[0] = state.sampleTime.unwrap(uint32(p.SampleTimeFine())).Seconds()
+		}
+	}},
+	{"utc_time", []string{"utc_time_s"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		row[0] = gen.UtcTimeSeconds(p)
+	}},
+	{"quaternion", []string{"quat_w", "quat_x", "quat_y", "quat_z"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsOrientation() {
+			q := quaternion(p.OrientationQuaternion())
+			row[0], row[1], row[2], row[3] = q.W, q.X, q.Y, q.Z
+		}
+	}},
+	{"euler", []string{"roll_deg", "pitch_deg", "yaw_deg"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsOrientation() {
+			euler := p.OrientationEuler()
+			row[0], row[1], row[2] = euler.Roll(), euler.Pitch(), euler.Yaw()
+			gen.DeleteXSEuler(euler)
+		}
+	}},
+	{"acceleration", []string{"acc_x_mps2", "acc_y_mps2", "acc_z_mps2"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsCalibratedAcceleration() {
+			putVector(row, vector3(p.CalibratedAcceleration()))
+		}
+	}},
+	{"free_acceleration", []string{"free_acc_x_mps2", "free_acc_y_mps2", "free_acc_z_mps2"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsFreeAcceleration() {
+			putVector(row, vector3(p.FreeAcceleration()))
+		}
+	}},
+	{"gyroscope", []string{"gyr_x_radps", "gyr_y_radps", "gyr_z_radps"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsCalibratedGyroscopeData() {
+			putVector(row, vector3(p.CalibratedGyroscopeData()))
+		}
+	}},
+	{"magnetometer", []string{"mag_x_au", "mag_y_au", "mag_z_au"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsCalibratedMagneticField() {
+			putVector(row, vector3(p.CalibratedMagneticField()))
+		}
+	}},
+	{"temperature", []string{"temperature_c"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsTemperature() {
+			row[0] = p.Temperature()
+		}
+	}},
+	{"position", []string{"latitude_deg", "longitude_deg", "altitude_m"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsLatitudeLongitude() {
+			latLon := p.LatitudeLongitude()
+			if gen.VectorSize(latLon) >= 2 {
+				row[0], row[1] = gen.VectorAt(latLon, 0), gen.VectorAt(latLon, 1)
+			}
+			gen.DeleteVector(latLon)
+		}
+		if p.ContainsAltitude() {
+			row[2] = p.Altitude()
+		}
+	}},
+	{"status", []string{"status"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsStatus() {
+			row[0] = float64(p.Status())
+		}
+	}},
+	{"saturated", []string{"saturated"}, func(p gen.XSDataPacket, row []float64, _ *exportState) {
+		if p.ContainsDetailedStatus() {
+			row[0] = 0
+			if uint32(p.Status())&statusClipMask != 0 {
+				row[0] = 1
+			}
+		}
+	}},
+}
+
+func putVector(row []float64, v r3.Vector) {
+	row[0], row[1], row[2] = v.X, v.Y, v.Z
+}
+
+// ExportFieldNames returns the names of all fields that can be exported.
+func ExportFieldNames() []string {
+	names := make([]string, 0, len(exportFields))
+	for _, field := range exportFields {
+		names = append(names, field.name)
+	}
+	return names
+}
+
+// Exporter converts packets into rows of a CSV, JSON Lines or columnar
+// file.
+type Exporter struct {
+	fields  []exportField
+	columns []string
+	row     []float64
+	state   exportState
+	writer  rowWriter
+}
+
+// NewExporter writes the named fields of every packet passed to
+// WritePacket to w in the given format. No fields means all fields.
+func NewExporter(w io.Writer, format string, fields []string) (*Exporter, error) {
+	e := &Exporter{}
+	if len(fields) == 0 {
+		fields = ExportFieldNames()
+	}
+	for _, name := range fields {
+		field, err := findExportField(name)
+		if err != nil {
+			return nil, err
+		}
+		e.fields = append(e.fields, field)
+		e.columns = append(e.columns, field.columns...)
+	}
+	e.row = make([]float64, len(e.columns))
+
+	var err error
+	switch strings.ToLower(format) {
+	case ExportCSV:
+		e.writer, err = newCSVWriter(w, e.columns)
+	case ExportJSONL:
+		e.writer, err = newJSONLWriter(w, e.columns)
+	case ExportColumnar:
+		e.writer, err = newColumnarWriter(w, e.columns)
+	case ExportTable:
+		e.writer, err = newTableWriter(w, e.columns)
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func findExportField(name string) (exportField, error) {
+	for _, field := range exportFields {
+		if strings.EqualFold(field.name, name) {
+			return field, nil
+		}
+	}
+	return exportField{}, fmt.Errorf("unknown export field %q, expected one of %v", name, ExportFieldNames())
+}
+
+// Columns returns the names of the exported columns.
+func (e *Exporter) Columns() []string {
+	return e.columns
+}
+
+// WritePacket writes one row for packet.
+func (e *Exporter) WritePacket(packet gen.XSDataPacket) error {
+	for i := range e.row {
+		e.row[i] = math.NaN()
+	}
+	offset := 0
+	for _, field := range e.fields {
+		field.extract(packet, e.row[offset:offset+len(field.columns)], &e.state)
+		offset += len(field.columns)
+	}
+	return e.writer.write(e.row)
+}
+
+// Close flushes any buffered rows. It does not close the underlying writer.
+func (e *Exporter) Close() error {
+	return e.writer.flush()
+}
+
+// ExportLogFile writes the packets of the .mtb file at path to w and returns
+// how many were written.
+func ExportLogFile(path string, w io.Writer, format string, fields []string) (int64, error) {
+	exporter, err := NewExporter(w, format, fields)
+	if err != nil {
+		return 0, err
+	}
+	count, err := ReadLogFile(path, exporter.WritePacket)
+	if err != nil {
+		return count, err
+	}
+	return count, exporter.Close()
+}
+
+// ReadLogFile loads the .mtb file at path and passes its packets to fn in
+// order until fn returns an error. It returns how many packets were passed.
+func ReadLogFile(path string, fn func(packet gen.XSDataPacket) error) (int64, error) {
+	control := gen.XsControlConstruct()
+	defer control.Destruct()
+	fileName := gen.NewXSString(path)
+	defer gen.DeleteXSString(fileName)
+	device := gen.OpenLogFileDevice(control, fileName)
+	if device.Swigcptr() == 0 {
+		return 0, controlError(control, ErrDeviceNotFound, "open an MTi device in log file %q", path)
+	}
+	if !device.LoadLogFile() {
+		return 0, deviceError(device, nil, "load log file %q", path)
+	}
+	device.WaitForLoadLogFileDone()
+
+	count := device.GetDataPacketCount()
+	for i := int64(0); i < count; i++ {
+		packet := device.GetDataPacketByIndex(i)
+		err := fn(packet)
+		gen.DeleteXSDataPacket(packet)
+		if err != nil {
+			return i, err
+		}
+	}
+	return count, nil
+}
+
+type rowWriter interface {
+	write(row []float64) error
+	flush() error
+}
+
+// csvWriter writes a header line and one line per row. Missing values are
+// left empty.
+type csvWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	c := &csvWriter{w: csv.NewWriter(w), record: make([]string, len(columns))}
+	return c, c.w.Write(columns)
+}
+
+func (c *csvWriter) write(row []float64) error {
+	for i, v := range row {
+		c.record[i] = ""
+		if !math.IsNaN(v) {
+			c.record[i] = strconv.FormatFloat(v, 'g', -1, 64)
+		}
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// tableWriter writes a header line and one fixed width line per row.
+// Missing values are shown as "-".
+type tableWriter struct {
+	w *bufio.Writer
+}
+
+const tableColumnWidth = 16
+
+func newTableWriter(w io.Writer, columns []string) (*tableWriter, error) {
+	t := &tableWriter{w: bufio.NewWriter(w)}
+	for _, column := range columns {
+		if _, err := fmt.Fprintf(t.w, "%*s", tableColumnWidth, column); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.w.WriteByte('\n'); err != nil {
+		return nil, err
+	}
+	return t, t.w.Flush()
+}
+
+func (t *tableWriter) write(row []float64) error {
+	for _, v := range row {
+		var err error
+		if math.IsNaN(v) {
+			_, err = fmt.Fprintf(t.w, "%*s", tableColumnWidth, "-")
+		} else {
+			_, err = fmt.Fprintf(t.w, "%*.6g", tableColumnWidth, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := t.w.WriteByte('\n'); err != nil {
+		return err
+	}
+	// rows are flushed right away so a terminal shows them as they come
+	return t.w.Flush()
+}
+
+func (t *tableWriter) flush() error {
+	return t.w.Flush()
+}
+
+// jsonlWriter writes one JSON object per row, with its values in column
+// order. Missing values are left out.
+type jsonlWriter struct {
+	w *bufio.Writer
+	// keys are the column names encoded as JSON strings
+	keys [][]byte
+	line []byte
+}
+
+func newJSONLWriter(w io.Writer, columns []string) (*jsonlWriter, error) {
+	j := &jsonlWriter{w: bufio.NewWriter(w), keys: make([][]byte, len(columns))}
+	for i, column := range columns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return nil, err
+		}
+		j.keys[i] = key
+	}
+	return j, nil
+}
+
+func (j *jsonlWriter) write(row []float64) error {
+	j.line = append(j.line[:0], '{')
+	for i, v := range row {
+		if math.IsNaN(v) {
+			continue
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if len(j.line) > 1 {
+			j.line = append(j.line, ',')
+		}
+		j.line = append(j.line, j.keys[i]...)
+		j.line = append(j.line, ':')
+		j.line = append(j.line, value...)
+	}
+	j.line = append(j.line, '}', '\n')
+	_, err := j.w.Write(j.line)
+	return err
+}
+
+func (j *jsonlWriter) flush() error {
+	return j.w.Flush()
+}
+
+// columnarWriter writes the compact columnar format: the magic line
+// "XSCOL1\n", a little endian uint32 column count and, per column, a uint16
+// name length and the name. Blocks of rows follow, each a uint32 row count
+// and then every column's values of the block as little endian float64s.
+// Missing values are NaN.
+type columnarWriter struct {
+	w      *bufio.Writer
+	blocks [][]float64
+	rows   int
+}
+
+func newColumnarWriter(w io.Writer, columns []string) (*columnarWriter, error) {
+	c := &columnarWriter{w: bufio.NewWriter(w), blocks: make([][]float64, len(columns))}
+	for i := range c.blocks {
+		c.blocks[i] = make([]float64, 0, columnarBlockRows)
+	}
+	if _, err := c.w.WriteString(columnarMagic); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(c.w, binary.LittleEndian, uint32(len(columns))); err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		if len(column) > math.MaxUint16 {
+			return nil, errors.New("column name too long")
+		}
+		if err := binary.Write(c.w, binary.LittleEndian, uint16(len(column))); err != nil {
+			return nil, err
+		}
+		if _, err := c.w.WriteString(column); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *columnarWriter) write(row []float64) error {
+	for i, v := range row {
+		c.blocks[i] = append(c.blocks[i], v)
+	}
+	c.rows++
+	if c.rows == columnarBlockRows {
+		return c.writeBlock()
+	}
+	return nil
+}
+
+func (c *columnarWriter) writeBlock() error {
+	if c.rows == 0 {
+		return nil
+	}
+	if err := binary.Write(c.w, binary.LittleEndian, uint32(c.rows)); err != nil {
+		return err
+	}
+	for i, block := range c.blocks {
+		if err := binary.Write(c.w, binary.LittleEndian, block); err != nil {
+			return err
+		}
+		c.blocks[i] = block[:0]
+	}
+	c.rows = 0
+	return nil
+}
+
+func (c *columnarWriter) flush() error {
+	if err := c.writeBlock(); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
@@ -0,0 +1,162 @@
+package serial
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// exportPacket is the data of a synthetic packet to export. A NaN
+// temperature leaves it out.
+type exportPacket struct {
+	counter     uint16
+	sampleTime  uint
+	temperature float64
+}
+
+var exportPackets = []exportPacket{
+	{counter: 7, sampleTime: 10000, temperature: 21.5},
+	{counter: 8, sampleTime: 20000, temperature: math.NaN()},
+}
+
+var exportTestFields = []string{"packet_counter", "sample_time", "temperature"}
+
+// export writes packets in format and returns the output.
+func export(t *testing.T, format string, packets []exportPacket) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	e, err := NewExporter(&buf, format, exportTestFields)
+	if err != nil {
+		t.Fatalf("NewExporter(%q) failed: %v", format, err)
+	}
+	for _, p := range packets {
+		packet := gen.NewXSDataPacket__SWIG_1()
+		packet.SetPacketCounter(p.counter)
+		packet.SetSampleTimeFine(p.sampleTime)
+		if !math.IsNaN(p.temperature) {
+			packet.SetTemperature(p.temperature)
+		}
+		err := e.WritePacket(packet)
+		gen.DeleteXSDataPacket(packet)
+		if err != nil {
+			t.Fatalf("WritePacket failed: %v", err)
+		}
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExportText(t *testing.T) {
+	for _, tc := range []struct {
+		format string
+		want   string
+	}{
+		{
+			format: ExportCSV,
+			want: "packet_counter,sample_time_s,temperature_c\n" +
+				"7,1,21.5\n" +
+				"8,2,\n",
+		},
+		{
+			format: ExportJSONL,
+			want: `{"packet_counter":7,"sample_time_s":1,"temperature_c":21.5}` + "\n" +
+				`{"packet_counter":8,"sample_time_s":2}` + "\n",
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			if got := string(export(t, tc.format, exportPackets)); got != tc.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExportColumnar(t *testing.T) {
+	r := bytes.NewReader(export(t, ExportColumnar, exportPackets))
+	magic := make([]byte, len(columnarMagic))
+	if _, err := r.Read(magic); err != nil || string(magic) != columnarMagic {
+		t.Fatalf("magic = %q, %v, want %q", magic, err, columnarMagic)
+	}
+	var columnCount uint32
+	if err := binary.Read(r, binary.LittleEndian, &columnCount); err != nil {
+		t.Fatal(err)
+	}
+	wantColumns := []string{"packet_counter", "sample_time_s", "temperature_c"}
+	if int(columnCount) != len(wantColumns) {
+		t.Fatalf("column count = %d, want %d", columnCount, len(wantColumns))
+	}
+	for _, want := range wantColumns {
+		var length uint16
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			t.Fatal(err)
+		}
+		name := make([]byte, length)
+		if _, err := r.Read(name); err != nil {
+			t.Fatal(err)
+		}
+		if string(name) != want {
+			t.Errorf("column = %q, want %q", name, want)
+		}
+	}
+
+	var rows uint32
+	if err := binary.Read(r, binary.LittleEndian, &rows); err != nil {
+		t.Fatal(err)
+	}
+	if int(rows) != len(exportPackets) {
+		t.Fatalf("rows = %d, want %d", rows, len(exportPackets))
+	}
+	for i, want := range [][]float64{{7, 8}, {1, 2}, {21.5, math.NaN()}} {
+		got := make([]float64, rows)
+		if err := binary.Read(r, binary.LittleEndian, got); err != nil {
+			t.Fatal(err)
+		}
+		for j := range want {
+			if got[j] != want[j] && !(math.IsNaN(got[j]) && math.IsNaN(want[j])) {
+				t.Errorf("%s[%d] = %v, want %v", wantColumns[i], j, got[j], want[j])
+			}
+		}
+	}
+	if r.Len() != 0 {
+		t.Errorf("%d bytes left after the block", r.Len())
+	}
+}
+
+func TestSampleTimeUnwrapper(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		raw  []uint32
+		want []time.Duration
+	}{
+		{
+			name: "in order",
+			raw:  []uint32{10000, 20000},
+			want: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name: "wraparound",
+			raw:  []uint32{math.MaxUint32 - 9, 10},
+			want: []time.Duration{(math.MaxUint32 - 9) * sampleTimeFineResolution, (math.MaxUint32 + 11) * sampleTimeFineResolution},
+		},
+		{
+			name: "backwards",
+			raw:  []uint32{20000, 10000},
+			want: []time.Duration{2 * time.Second, time.Second},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var u sampleTimeUnwrapper
+			for i, raw := range tc.raw {
+				if got := u.unwrap(raw); got != tc.want[i] {
+					t.Errorf("unwrap(%d) = %v, want %v", raw, got, tc.want[i])
+				}
+			}
+		})
+	}
+}
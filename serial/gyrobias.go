@@ -0,0 +1,347 @@
+package serial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+const (
+	// defaultNoRotationDuration is how long the platform is held still for
+	// a bias estimate unless the command says otherwise.
+	defaultNoRotationDuration = 10 * time.Second
+	maxNoRotationDuration     = 10 * time.Minute
+	// noRotationMargin is how long past the requested duration the device
+	// is given to report the update finished.
+	noRotationMargin = 3 * time.Second
+	// stillCheckDuration is how long the data is checked for stillness
+	// before an update is started, and how long the residual bias is
+	// measured after it.
+	stillCheckDuration = time.Second
+	// stillGyroPeak in rad/s and stillAccelerationStd in m/s^2 bound the
+	// angular rate and the spread of the specific force's magnitude of a
+	// platform taken as still.
+	stillGyroPeak        = 0.035
+	stillAccelerationStd = 0.05
+	// startupStillTimeout is how long the startup bias estimate waits for
+	// the platform to be still.
+	startupStillTimeout = 30 * time.Second
+)
+
+var (
+	// errNotStill is returned when the platform moves while checked for
+	// stillness.
+	errNotStill = errors.New("platform is not still")
+	// errNoGyroscopeData is returned when the device does not output the
+	// calibrated gyroscope data stillness is checked with.
+	errNoGyroscopeData = errors.New("no calibrated gyroscope data, add it to the output configuration")
+)
+
+// MotionStats summarize the inertial data of a stretch of packets.
+type MotionStats struct {
+	Samples int `json:"samples"`
+	// GyroMean is the mean calibrated angular rate in rad/s, which while
+	// still is the bias the device does not compensate.
+	GyroMean r3.Vector `json:"gyro_mean"`
+	// GyroPeak is the largest angular rate magnitude in rad/s.
+	GyroPeak float64 `json:"gyro_peak"`
+	// AccelerationStd is the standard deviation of the specific force's
+	// magnitude in m/s^2.
+	AccelerationStd float64 `json:"acceleration_std"`
+}
+
+// Still reports whether the stats are of a platform that did not move.
+func (s MotionStats) Still() bool {
+	return s.Samples > 0 && s.GyroPeak < stillGyroPeak && s.AccelerationStd < stillAccelerationStd
+}
+
+// motionMonitor accumulates MotionStats and the no rotation update state
+// from the packets handled while it is installed.
+type motionMonitor struct {
+	mu       sync.Mutex
+	gyroSum  r3.Vector
+	stats    MotionStats
+	accN     int
+	accSum   float64
+	accSumSq float64
+
+	// the no rotation update bits of the status word, and whether the
+	// update was seen running, aborted or rejecting samples
+	hasStatus  bool
+	noRotation uint32
+	running    bool
+	aborted    bool
+	rejected   bool
+}
+
+func (m *motionMonitor) update(packet gen.XSDataPacket) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if packet.ContainsCalibratedGyroscopeData() {
+		gyro := vector3(packet.CalibratedGyroscopeData())
+		m.stats.Samples++
+		m.gyroSum = m.gyroSum.Add(gyro)
+		m.stats.GyroPeak = math.Max(m.stats.GyroPeak, gyro.Norm())
+	}
+	if packet.ContainsCalibratedAcceleration() {
+		norm := vector3(packet.CalibratedAcceleration()).Norm()
+		m.accN++
+		m.accSum += norm
+		m.accSumSq += norm * norm
+	}
+	if packet.ContainsStatus() {
+		m.hasStatus = true
+		m.noRotation = uint32(packet.Status()) & statusNoRotationMask
+		switch m.noRotation {
+		case statusNoRotationMask:
+			m.running = true
+		case statusNoRotationAborted:
+			m.aborted = true
+		case statusNoRotationRejected:
+			m.rejected = true
+		}
+	}
+}
+
+// restart starts a new stretch of packets.
+func (m *motionMonitor) restart() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gyroSum, m.stats = r3.Vector{}, MotionStats{}
+	m.accN, m.accSum, m.accSumSq = 0, 0, 0
+}
+
+// motion returns the stats of the packets since the last restart.
+func (m *motionMonitor) motion() MotionStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := m.stats
+	if stats.Samples > 0 {
+		stats.GyroMean = m.gyroSum.Mul(1 / float64(stats.Samples))
+	}
+	if m.accN > 1 {
+		mean := m.accSum / float64(m.accN)
+		stats.AccelerationStd = math.Sqrt(math.Max(m.accSumSq/float64(m.accN)-mean*mean, 0))
+	}
+	return stats
+}
+
+// noRotationState names the state of the device's no rotation update and
+// reports whether it is over. Without the status in the output
+// configuration the state is "unknown".
+func (m *motionMonitor) noRotationState() (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch {
+	case !m.hasStatus:
+		return "unknown", false
+	case m.noRotation == statusNoRotationMask:
+		return "running", false
+	case m.noRotation == statusNoRotationAborted:
+		return "aborted", true
+	case m.running && m.rejected:
+		return "samples_rejected", true
+	case m.running:
+		return "completed", true
+	default:
+		return "not_started", false
+	}
+}
+
+// GyroBiasEstimate is the outcome of a no rotation update.
+type GyroBiasEstimate struct {
+	Duration time.Duration
+	// State is how the device reported the update ended: "completed",
+	// "aborted" or "samples_rejected". It is "unknown" without the status in
+	// the output configuration and "not_started" when the device never
+	// reported the update running.
+	State string
+	// During is the motion during the update, whose GyroMean is the residual
+	// bias the update estimated, and After the motion right after it, whose
+	// GyroMean is the bias left uncompensated.
+	During MotionStats
+	After  MotionStats
+}
+
+// startMotionMonitor installs a motionMonitor, of which there is one at a
+// time.
+func (c *Compass) startMotionMonitor() (*motionMonitor, error) {
+	c.motionMu.Lock()
+	defer c.motionMu.Unlock()
+	if m, _ := c.motion.Load().(*motionMonitor); m != nil {
+		return nil, errors.New("already estimating the gyroscope bias")
+	}
+	m := &motionMonitor{}
+	c.motion.Store(m)
+	return m, nil
+}
+
+func (c *Compass) stopMotionMonitor() {
+	c.motionMu.Lock()
+	defer c.motionMu.Unlock()
+	c.motion.Store((*motionMonitor)(nil))
+}
+
+// waitPackets waits for d of packets to be handled.
+func (c *Compass) waitPackets(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closeCh:
+		return errHandleClosed
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// estimateGyroBias checks that the platform is still, has the device run a
+// no rotation update for duration and measures the bias left afterwards.
+// It must be called without c.mu held, as it waits for packets.
+func (c *Compass) estimateGyroBias(ctx context.Context, duration time.Duration) (GyroBiasEstimate, error) {
+	estimate := GyroBiasEstimate{Duration: duration}
+	monitor, err := c.startMotionMonitor()
+	if err != nil {
+		return estimate, err
+	}
+	defer c.stopMotionMonitor()
+
+	if err := c.waitPackets(ctx, stillCheckDuration); err != nil {
+		return estimate, err
+	}
+	before := monitor.motion()
+	if before.Samples == 0 {
+		return estimate, errNoGyroscopeData
+	}
+	if !before.Still() {
+		return estimate, fmt.Errorf("%w: peak angular rate %.3f rad/s, acceleration spread %.3f m/s^2",
+			errNotStill, before.GyroPeak, before.AccelerationStd)
+	}
+
+	c.mu.Lock()
+	if !c.connected {
+		c.mu.Unlock()
+		return estimate, fmt.Errorf("device %s is disconnected: %w", c.cfg.DeviceID, ErrConnectionLost)
+	}
+	if !c.device.SetNoRotation(uint16(duration / time.Second)) {
+		err := deviceError(c.device, nil, "start no rotation update")
+		c.mu.Unlock()
+		return estimate, err
+	}
+	c.mu.Unlock()
+	c.logger.Infow("estimating gyroscope bias", "id", c.cfg.DeviceID, "duration", duration)
+	monitor.restart()
+
+	// the update is over once the device reports it, or after the duration
+	// when the device does not report it running
+	end := time.Now().Add(duration)
+	for {
+		if err := c.waitPackets(ctx, 100*time.Millisecond); err != nil {
+			return estimate, err
+		}
+		state, over := monitor.noRotationState()
+		estimate.State = state
+		if over || (state != "running" && time.Now().After(end)) {
+			break
+		}
+		if time.Now().After(end.Add(noRotationMargin)) {
+			return estimate, fmt.Errorf("no rotation update did not finish within %v: %w", duration+noRotationMargin, ErrTimeout)
+		}
+	}
+	estimate.During = monitor.motion()
+
+	monitor.restart()
+	if err := c.waitPackets(ctx, stillCheckDuration); err != nil {
+		return estimate, err
+	}
+	estimate.After = monitor.motion()
+	c.logger.Infow("estimated gyroscope bias",
+		"id", c.cfg.DeviceID,
+		"state", estimate.State,
+		"still", estimate.During.Still(),
+		"residual_bias", estimate.During.GyroMean,
+		"bias_after", estimate.After.GyroMean,
+	)
+	return estimate, nil
+}
+
+// gyroBiasCommand implements the "estimate_gyro_bias" command. It is called
+// without c.mu held so the device can be read while the update runs.
+func (c *Compass) gyroBiasCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
+	duration := defaultNoRotationDuration
+	if v, ok := cmd["seconds"].(float64); ok {
+		duration = time.Duration(v * float64(time.Second)).Round(time.Second)
+	}
+	if duration < time.Second || duration > maxNoRotationDuration {
+		return nil, fmt.Errorf("seconds must be from 1 to %v", maxNoRotationDuration.Seconds())
+	}
+	result := map[string]interface{}{"command": "estimate_gyro_bias"}
+	if enable, ok := cmd["initial_bias_update"].(bool); ok {
+		if err := c.setInitialBiasUpdate(enable); err != nil {
+			return nil, err
+		}
+		result["initial_bias_update"] = enable
+	}
+
+	estimate, err := c.estimateGyroBias(ctx, duration)
+	if err != nil {
+		return nil, err
+	}
+	result["duration_sec"] = estimate.Duration.Seconds()
+	result["state"] = estimate.State
+	result["still"] = estimate.During.Still()
+	result["residual_bias_rad_s"] = vectorMap(estimate.During.GyroMean)
+	result["residual_bias_deg_s"] = vectorMap(estimate.During.GyroMean.Mul(180 / math.Pi))
+	result["bias_after_rad_s"] = vectorMap(estimate.After.GyroMean)
+	result["gyro_peak_rad_s"] = estimate.During.GyroPeak
+	result["acceleration_std"] = estimate.During.AccelerationStd
+	result["samples"] = estimate.During.Samples
+	return result, nil
+}
+
+// setInitialBiasUpdate turns the device's bias estimate during the first
+// seconds after startup on or off.
+func (c *Compass) setInitialBiasUpdate(enable bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.connected {
+		return fmt.Errorf("device %s is disconnected: %w", c.cfg.DeviceID, ErrConnectionLost)
+	}
+	return c.withConfigMode(func() error {
+		if !c.device.SetInitialBiasUpdateEnabled(enable) {
+			return deviceError(c.device, ErrConfigRejected, "set initial bias update to %v", enable)
+		}
+		return nil
+	})
+}
+
+func vectorMap(v r3.Vector) map[string]interface{} {
+	return map[string]interface{}{"x": v.X, "y": v.Y, "z": v.Z}
+}
+
+// gyroBiasOnStartup runs a bias estimate once the platform is still, giving
+// up after startupStillTimeout.
+func (c *Compass) gyroBiasOnStartup(duration time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), startupStillTimeout+duration+2*noRotationMargin)
+	defer cancel()
+	stillDeadline := time.Now().Add(startupStillTimeout)
+	for {
+		_, err := c.estimateGyroBias(ctx, duration)
+		switch {
+		case err == nil:
+			return
+		case errors.Is(err, errNotStill) && time.Now().Before(stillDeadline):
+			continue
+		case errors.Is(err, errHandleClosed):
+			return
+		default:
+			c.logger.Warnw("skipped gyroscope bias estimate on startup", "id", c.cfg.DeviceID, "error", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package serial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+const (
+	// devicePollInterval is how often to scan for a missing device when no
+	// change under /dev is seen.
+	devicePollInterval = 5 * time.Second
+	// deviceSettleDelay gives a newly enumerated device time to come up
+	// before it is scanned for.
+	deviceSettleDelay = 500 * time.Millisecond
+	// connectionCheckInterval is how often the port of a connected device
+	// is checked for having disappeared.
+	connectionCheckInterval = time.Second
+)
+
+// WaitForDevice scans for a device matching opts until one is found or ctx
+// is done. Between scans it waits for a change under /dev, falling back to
+// polling.
+func WaitForDevice(ctx context.Context, opts DiscoverOptions) (DiscoveredDevice, error) {
+	logger := opts.logger()
+	var events chan fsnotify.Event
+	if watcher, err := fsnotify.NewWatcher(); err != nil {
+		logger.Debugw("cannot watch /dev, polling for devices instead", "error", err)
+	} else {
+		defer watcher.Close()
+		if err := watcher.Add("/dev"); err != nil {
+			logger.Debugw("cannot watch /dev, polling for devices instead", "error", err)
+		} else {
+			events = watcher.Events
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		found, err := Discover(ctx, opts)
+		if err != nil {
+			return DiscoveredDevice{}, err
+		}
+		if len(found) > 0 {
+			return found[0], nil
+		}
+		if attempt == 0 {
+			logger.Infow("waiting for device", "id", opts.DeviceID, "port", opts.Port)
+		}
+		select {
+		case <-ctx.Done():
+			return DiscoveredDevice{}, ctx.Err()
+		case <-events:
+			select {
+			case <-ctx.Done():
+				return DiscoveredDevice{}, ctx.Err()
+			case <-time.After(deviceSettleDelay):
+			}
+		case <-time.After(devicePollInterval):
+		}
+	}
+}
+
+// openDevice opens the port at path and returns the device with the given
+// ID on it, in config mode.
+func openDevice(control gen.XsControl, path string, baudRate gen.XsBaudRate, deviceID string) (gen.XSDevice, error) {
+	pathStr := gen.NewXSString(path)
+	defer gen.DeleteXSString(pathStr)
+	if !control.OpenPort(pathStr, baudRate) {
+		return nil, portError(control, path)
+	}
+
+	devID := gen.NewXSDeviceId()
+	defer gen.DeleteXSDeviceId(devID)
+	devIDStr := gen.NewXSString(deviceID)
+	defer gen.DeleteXSString(devIDStr)
+	devID.FromString(devIDStr)
+
+	device := control.Device(devID)
+	if device.Swigcptr() == 0 {
+		control.ClosePort(pathStr)
+		return nil, fmt.Errorf("no device %s on %q: %w", deviceID, path, ErrDeviceNotFound)
+	}
+	return device, nil
+}
+
+// discoverOptions selects the configured device.
+func (cfg Config) discoverOptions() DiscoverOptions {
+	return DiscoverOptions{
+		DeviceID:    cfg.DeviceID,
+		Port:        cfg.Path,
+		USBBus:      cfg.USBBus,
+		USBAddress:  cfg.USBAddress,
+		BaudRate:    cfg.BaudRate,
+		Logger:      cfg.Logger,
+		SDKLogLevel: cfg.SDKLogLevel,
+	}
+}
+
+// watch notices when the port of h disappears, which is how an unplugged
+// USB device shows, and reattaches to the device once it is plugged back in.
+func (m *deviceManager) watch(h *deviceHandle) {
+	ctx, cancel := handleContext(h)
+	defer cancel()
+
+	ticker := time.NewTicker(connectionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !m.detachIfGone(h) {
+			continue
+		}
+
+		// the port may come back under another name, so the configured
+		// path, not the last one, is looked for
+		found, err := WaitForDevice(ctx, h.discover)
+		if err != nil {
+			return
+		}
+		for {
+			err = m.reattach(h, found.Port)
+			if err == nil {
+				break
+			}
+			if errors.Is(err, errHandleClosed) {
+				return
+			}
+			h.discover.logger().Warnw("failed to reattach device, retrying", "id", h.id, "port", found.Port, "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(devicePollInterval):
+			}
+		}
+	}
+}
+
+// detachIfGone closes the port of h if it disappeared and tells the users
+// of h. It returns whether the device was detached.
+func (m *deviceManager) detachIfGone(h *deviceHandle) bool {
+	m.mu.Lock()
+	if !h.connected || h.resetting > 0 {
+		m.mu.Unlock()
+		return false
+	}
+	if _, err := os.Stat(h.port); err == nil {
+		m.mu.Unlock()
+		return false
+	}
+	// the port is closed only once the users stopped using the device, which
+	// the SDK frees with it, and the reference held meanwhile keeps the
+	// XsControl open
+	h.connected = false
+	h.refs++
+	port := h.port
+	m.mu.Unlock()
+
+	// users are told without m.mu held as they may be waiting on it with
+	// their own lock held
+	for _, c := range m.users(h) {
+		c.detached()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// a reset may have found the device again meanwhile
+	if !h.connected {
+		m.closePort(port)
+	}
+	m.unref(h)
+	return true
+}
+
+// reattach opens the device of h on port, starts measuring and tells the
+// users of h.
+func (m *deviceManager) reattach(h *deviceHandle, port string) error {
+	m.mu.Lock()
+	select {
+	case <-h.closeCh:
+		m.mu.Unlock()
+		return errHandleClosed
+	default:
+	}
+	device, err := openDevice(m.control, port, h.baudRate, h.id)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	if !device.GotoMeasurement() {
+		err := deviceError(device, nil, "go to measurement mode")
+		m.closePort(port)
+		m.mu.Unlock()
+		return err
+	}
+	h.device = device
+	h.port = port
+	h.connected = true
+	m.mu.Unlock()
+
+	m.notifyAttached(h, device, port, nil)
+	return nil
+}
+
+// detached stops using a device which was unplugged.
+func (c *Compass) detached() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.recorder != nil {
+		cfg := c.recorder.cfg
+		if _, err := c.stopRecording(); err != nil {
+			c.logger.Debugw("recording stopped with error", "error", err)
+		}
+		c.suspendedRecording = &cfg
+	}
+	c.connected = false
+	c.storeLink()
+	c.logger.Warnw("device disconnected", "id", c.cfg.DeviceID, "port", c.cfg.Path)
+}
+
+// attached starts using a device which was plugged back in, or which came
+// back as another device after another user of it reset it. As it may be a
+// replacement with the same ID, e.g. a factory fresh one, it is set up again
+// like at startup.
+func (c *Compass) attached(device gen.XSDevice, port string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.closeCh:
+		return
+	default:
+	}
+	// a later reattach or reset may have overtaken this one
+	if current, _, connected := devices.state(c.handle); !connected || current.Swigcptr() != device.Swigcptr() {
+		return
+	}
+	c.device = device
+	c.cfg.Path = port
+	c.clipping.setRanges(device)
+	var err error
+	if !device.GotoConfig() {
+		err = deviceError(device, nil, "go to config mode")
+	} else {
+		err = c.setup()
+	}
+	if err != nil {
+		// the device is used as it is rather than not at all
+		c.logger.Errorw("failed to set up reattached device", "id", c.cfg.DeviceID, "error", err)
+		gen.AddCallbackHandler(c.callback, device)
+		if !device.GotoMeasurement() {
+			c.logger.Warnw("failed to go back to measurement mode", "id", c.cfg.DeviceID)
+		}
+	}
+	c.connected = true
+	c.reconnects++
+	c.storeLink()
+	c.logger.Infow("device reattached", append(deviceFields(device, port), "reconnects", c.reconnects)...)
+	if cfg := c.suspendedRecording; cfg != nil {
+		c.suspendedRecording = nil
+		if err := c.startRecording(*cfg); err != nil {
+			c.logger.Errorw("failed to resume recording", "id", c.cfg.DeviceID, "error", err)
+			c.recordingEnded = "failed to resume after the device was reattached: " + err.Error()
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package serial
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// SDKLogLevels are the lowest levels of the SDK's own log output that can be
+// logged. "off" drops it. A release build of the SDK compiles out journal
+// entries below alert, so "debug" and "info" only add the port scanner's
+// lines unless the SDK is built with XSENS_DEBUG.
+var SDKLogLevels = []string{"debug", "info", "warn", "error", "off"}
+
+// Log levels, ordered so that a message is logged when its level is at least
+// the configured one.
+const (
+	logDebug = iota
+	logInfo
+	logWarn
+	logError
+	logOff
+)
+
+// ValidateSDKLogLevel checks that level is one of SDKLogLevels or empty,
+// which means "debug".
+func ValidateSDKLogLevel(level string) error {
+	if level == "" {
+		return nil
+	}
+	for _, valid := range SDKLogLevels {
+		if level == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sdk log level %q, expected one of %v", level, SDKLogLevels)
+}
+
+// parseSDKLogLevel returns the log level named by level, which must be valid.
+func parseSDKLogLevel(level string) int {
+	for i, name := range SDKLogLevels {
+		if level == name {
+			return i
+		}
+	}
+	return logDebug
+}
+
+// logAt logs msg with keysAndValues at level.
+func logAt(logger golog.Logger, level int, msg string, keysAndValues ...interface{}) {
+	switch level {
+	case logDebug:
+		logger.Debugw(msg, keysAndValues...)
+	case logInfo:
+		logger.Infow(msg, keysAndValues...)
+	case logWarn:
+		logger.Warnw(msg, keysAndValues...)
+	default:
+		logger.Errorw(msg, keysAndValues...)
+	}
+}
+
+// sdkLogFunc returns a function logging the lines of the SDK's scan log,
+// which have no level of their own, to logger at level, or nil when level is
+// "off".
+func sdkLogFunc(logger golog.Logger, level string) func(string) {
+	logLevel := parseSDKLogLevel(level)
+	if logLevel == logOff {
+		return nil
+	}
+	return func(line string) {
+		if line = strings.TrimSpace(line); line != "" {
+			logAt(logger, logLevel, line, "source", "sdk_scanner")
+		}
+	}
+}
+
+// scanMu serializes port scans, as the SDK has a single scan log callback.
+var scanMu sync.Mutex
+
+// journalLogLevel maps a level of the SDK's journal to a log level.
+func journalLogLevel(level int) int {
+	switch level {
+	case gen.JournalTrace, gen.JournalDebug:
+		return logDebug
+	case gen.JournalWrite:
+		return logInfo
+	case gen.JournalAlert:
+		return logWarn
+	default:
+		return logError
+	}
+}
+
+// journalSink is a logger the SDK's journal is logged to.
+type journalSink struct {
+	owner  interface{}
+	logger golog.Logger
+	level  int
+}
+
+// journalSinks are the loggers of the components using the SDK, in the order
+// they started. The journal is not per device, so each entry is only logged
+// to the first of them that logs its level.
+var journalSinks struct {
+	sync.Mutex
+	sinks []journalSink
+}
+
+// addJournalSink logs the SDK's journal to logger at level until
+// removeJournalSink is called with owner.
+func addJournalSink(owner interface{}, logger golog.Logger, level string) {
+	journalSinks.Lock()
+	defer journalSinks.Unlock()
+	journalSinks.sinks = append(journalSinks.sinks, journalSink{owner, logger, parseSDKLogLevel(level)})
+	updateJournalHandler()
+}
+
+func removeJournalSink(owner interface{}) {
+	journalSinks.Lock()
+	defer journalSinks.Unlock()
+	for i, sink := range journalSinks.sinks {
+		if sink.owner == owner {
+			journalSinks.sinks = append(journalSinks.sinks[:i], journalSinks.sinks[i+1:]...)
+			break
+		}
+	}
+	updateJournalHandler()
+}
+
+// updateJournalHandler has the SDK pass the journal entries to Go from the
+// lowest level any sink logs. It must be called with journalSinks locked.
+func updateJournalHandler() {
+	lowest := logOff
+	for _, sink := range journalSinks.sinks {
+		if sink.level < lowest {
+			lowest = sink.level
+		}
+	}
+	switch lowest {
+	case logOff:
+		gen.SetJournalHandler(gen.JournalDisable, nil)
+	case logDebug:
+		gen.SetJournalHandler(gen.JournalDebug, logJournalEntry)
+	default:
+		gen.SetJournalHandler(gen.JournalAlert, logJournalEntry)
+	}
+}
+
+func logJournalEntry(level int, function, msg string) {
+	logLevel := journalLogLevel(level)
+	journalSinks.Lock()
+	var logger golog.Logger
+	for _, sink := range journalSinks.sinks {
+		if logLevel >= sink.level {
+			logger = sink.logger
+			break
+		}
+	}
+	journalSinks.Unlock()
+	if logger != nil {
+		logAt(logger, logLevel, strings.TrimSpace(msg), "source", "sdk_journal", "function", function)
+	}
+}
+
+// openSDKJournal has the SDK write its journal to the file at path, for
+// Xsens support. There is one journal per process, which moves to the
+// latest path opened.
+func openSDKJournal(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	// the SDK does not report whether it could open the file
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	gen.OpenJournal(path, gen.JournalDebug)
+	return nil
+}
+
+// deviceFields are the structured log fields identifying device.
+func deviceFields(device gen.XSDevice, port string) []interface{} {
+	id := device.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	return []interface{}{
+		"id", deviceIDString(id),
+		"port", port,
+		"product_code", strings.TrimSpace(goString(device.ProductCode())),
+		"firmware_version", versionString(device.FirmwareVersion()),
+		"hardware_version", versionString(device.HardwareVersion()),
+	}
+}
@@ -0,0 +1,542 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// maxMagSamples bounds the samples a calibration keeps, and
+	// magSampleSpacing is how far in relative field strength a sample must
+	// be from the last one kept, so that holding still adds nothing.
+	maxMagSamples    = 5000
+	magSampleSpacing = 0.02
+	// minMagSamples and minMagCoverage are the samples and the fraction of
+	// the sphere of field directions a calibration needs before it is fit.
+	minMagSamples  = 200
+	minMagCoverage = 0.6
+	// magBands of equal area from pole to pole, each split into magSectors,
+	// are the cells of the sphere coverage is counted in.
+	magBands   = 6
+	magSectors = 12
+	// goodMagResidual and fairMagResidual bound the relative RMS residual of
+	// the corrected field strength of a good and a fair fit.
+	goodMagResidual = 0.02
+	fairMagResidual = 0.05
+)
+
+// MagCalibration is a hard and soft iron correction of the magnetometer,
+// which corrects a field m to Matrix * (m - Offset).
+type MagCalibration struct {
+	// Offset is the hard iron offset in the magnetometer's units.
+	Offset [3]float64 `json:"offset"`
+	// Matrix is the soft iron correction. It is fit with a determinant of 1,
+	// keeping the average field strength.
+	Matrix [3][3]float64 `json:"matrix"`
+}
+
+// Validate checks that the correction can be applied.
+func (m *MagCalibration) Validate() error {
+	for i := 0; i < 3; i++ {
+		for _, v := range append([]float64{m.Offset[i]}, m.Matrix[i][:]...) {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return errors.New("magnetometer calibration has a value that is not a number")
+			}
+		}
+	}
+	if det := mat.Det(m.matrix()); math.Abs(det) < 1e-6 {
+		return fmt.Errorf("magnetometer calibration matrix is singular (determinant %g)", det)
+	}
+	return nil
+}
+
+func (m *MagCalibration) matrix() *mat.Dense {
+	data := make([]float64, 0, 9)
+	for _, row := range m.Matrix {
+		data = append(data, row[:]...)
+	}
+	return mat.NewDense(3, 3, data)
+}
+
+// apply returns the corrected field.
+func (m *MagCalibration) apply(v r3.Vector) r3.Vector {
+	d := [3]float64{v.X - m.Offset[0], v.Y - m.Offset[1], v.Z - m.Offset[2]}
+	var out [3]float64
+	for i, row := range m.Matrix {
+		out[i] = row[0]*d[0] + row[1]*d[1] + row[2]*d[2]
+	}
+	return r3.Vector{X: out[0], Y: out[1], Z: out[2]}
+}
+
+// toMap returns m in the form of the mag_calibration attribute.
+func (m *MagCalibration) toMap() map[string]interface{} {
+	matrix := make([]interface{}, 3)
+	for i, row := range m.Matrix {
+		matrix[i] = append([]float64(nil), row[:]...)
+	}
+	return map[string]interface{}{
+		"offset": append([]float64(nil), m.Offset[:]...),
+		"matrix": matrix,
+	}
+}
+
+// magneticYaw returns the yaw in degrees of a sensor measuring field in its
+// frame while at roll and pitch in degrees. Like the device's yaw it is 0
+// when the x axis faces magnetic north and 90 when it faces west.
+func magneticYaw(field r3.Vector, roll, pitch float64) float64 {
+	sinRoll, cosRoll := math.Sincos(roll * math.Pi / 180)
+	sinPitch, cosPitch := math.Sincos(pitch * math.Pi / 180)
+	// level the field by undoing the roll about x and then the pitch about y
+	y := field.Y*cosRoll - field.Z*sinRoll
+	z := field.Y*sinRoll + field.Z*cosRoll
+	x := field.X*cosPitch + z*sinPitch
+	return math.Atan2(-y, x) * 180 / math.Pi
+}
+
+// MagCoverage is how much of the sphere of field directions a calibration
+// has seen.
+type MagCoverage struct {
+	// Fraction is the fraction of the cells of the sphere with a sample.
+	Fraction float64
+	// Bands is the fraction of the cells covered per band of latitude, from
+	// the sensor's -z to its +z axis.
+	Bands [magBands]float64
+	// Uncovered is the mean direction of the empty cells in the sensor frame,
+	// or zero when all are covered.
+	Uncovered r3.Vector
+}
+
+// magCellCenter is the direction through the center of a cell.
+func magCellCenter(band, sector int) r3.Vector {
+	z := -1 + (float64(band)+0.5)*2/magBands
+	phi := (float64(sector) + 0.5) * 2 * math.Pi / magSectors
+	rho := math.Sqrt(1 - z*z)
+	return r3.Vector{X: rho * math.Cos(phi), Y: rho * math.Sin(phi), Z: z}
+}
+
+// magCell returns the cell direction d is in. Bands are of equal height in z
+// and so of equal area.
+func magCell(d r3.Vector) (int, int) {
+	d = d.Normalize()
+	band := int((d.Z + 1) / 2 * magBands)
+	if band >= magBands {
+		band = magBands - 1
+	}
+	phi := math.Atan2(d.Y, d.X)
+	if phi < 0 {
+		phi += 2 * math.Pi
+	}
+	sector := int(phi / (2 * math.Pi) * magSectors)
+	if sector >= magSectors {
+		sector = magSectors - 1
+	}
+	return band, sector
+}
+
+// magCoverage counts the cells of the directions of samples seen from the
+// center of their bounding box, which is close to the hard iron offset
+// before any fit.
+func magCoverage(samples []r3.Vector) MagCoverage {
+	var coverage MagCoverage
+	if len(samples) == 0 {
+		return coverage
+	}
+	lo, hi := samples[0], samples[0]
+	for _, s := range samples[1:] {
+		lo = r3.Vector{X: math.Min(lo.X, s.X), Y: math.Min(lo.Y, s.Y), Z: math.Min(lo.Z, s.Z)}
+		hi = r3.Vector{X: math.Max(hi.X, s.X), Y: math.Max(hi.Y, s.Y), Z: math.Max(hi.Z, s.Z)}
+	}
+	center := lo.Add(hi).Mul(0.5)
+	var covered [magBands][magSectors]bool
+	for _, s := range samples {
+		if d := s.Sub(center); d.Norm() > 0 {
+			band, sector := magCell(d)
+			covered[band][sector] = true
+		}
+	}
+	filled := 0
+	for band := range covered {
+		bandFilled := 0
+		for sector, ok := range covered[band] {
+			if ok {
+				bandFilled++
+			} else {
+				coverage.Uncovered = coverage.Uncovered.Add(magCellCenter(band, sector))
+			}
+		}
+		coverage.Bands[band] = float64(bandFilled) / magSectors
+		filled += bandFilled
+	}
+	coverage.Fraction = float64(filled) / (magBands * magSectors)
+	if coverage.Uncovered.Norm() > 0 {
+		coverage.Uncovered = coverage.Uncovered.Normalize()
+	}
+	return coverage
+}
+
+// coverageHint tells which way to turn the sensor to fill the uncovered
+// cells, which are directions of the field in the sensor frame.
+func coverageHint(coverage MagCoverage) string {
+	d := coverage.Uncovered
+	if d.Norm() == 0 {
+		return "all directions covered"
+	}
+	axis, value := "x", d.X
+	if math.Abs(d.Y) > math.Abs(value) {
+		axis, value = "y", d.Y
+	}
+	if math.Abs(d.Z) > math.Abs(value) {
+		axis, value = "z", d.Z
+	}
+	sign := "+"
+	if value < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("turn the sensor's %s%s axis towards magnetic north and down (up in the southern hemisphere)", sign, axis)
+}
+
+// MagFit is an ellipsoid fit of magnetometer samples.
+type MagFit struct {
+	Calibration MagCalibration
+	// FieldStrength is the mean corrected field strength.
+	FieldStrength float64
+	// RawSpread is the relative RMS deviation of the uncorrected field
+	// strength, and Residual and MaxResidual the relative RMS and largest
+	// deviation of the corrected one.
+	RawSpread   float64
+	Residual    float64
+	MaxResidual float64
+	// Anisotropy is the ratio of the longest to the shortest axis of the
+	// ellipsoid.
+	Anisotropy float64
+}
+
+// Quality grades the fit as "good", "fair" or "poor".
+func (f MagFit) Quality() string {
+	switch {
+	case f.Residual < goodMagResidual:
+		return "good"
+	case f.Residual < fairMagResidual:
+		return "fair"
+	default:
+		return "poor"
+	}
+}
+
+// fitEllipsoid fits the ellipsoid
+//
+//	a x² + b y² + c z² + 2f yz + 2g xz + 2h xy + 2p x + 2q y + 2r z = 1
+//
+// to samples by least squares and returns the correction mapping it onto a
+// sphere. The samples are centered and scaled first for a well conditioned
+// fit.
+func fitEllipsoid(samples []r3.Vector) (MagFit, error) {
+	var fit MagFit
+	if len(samples) < minMagSamples {
+		return fit, fmt.Errorf("%d magnetometer samples are too few to fit, need %d", len(samples), minMagSamples)
+	}
+	var mean r3.Vector
+	for _, s := range samples {
+		mean = mean.Add(s)
+	}
+	mean = mean.Mul(1 / float64(len(samples)))
+	scale := 0.0
+	for _, s := range samples {
+		scale = math.Max(scale, s.Sub(mean).Norm())
+	}
+	if scale == 0 {
+		return fit, errors.New("magnetometer samples do not vary")
+	}
+
+	design := mat.NewDense(len(samples), 9, nil)
+	ones := mat.NewVecDense(len(samples), nil)
+	for i, s := range samples {
+		p := s.Sub(mean).Mul(1 / scale)
+		design.SetRow(i, []float64{p.X * p.X, p.Y * p.Y, p.Z * p.Z, 2 * p.Y * p.Z, 2 * p.X * p.Z, 2 * p.X * p.Y, 2 * p.X, 2 * p.Y, 2 * p.Z})
+		ones.SetVec(i, 1)
+	}
+	var coef mat.VecDense
+	if err := coef.SolveVec(design, ones); err != nil {
+		return fit, fmt.Errorf("failed to fit ellipsoid: %w", err)
+	}
+	v := coef.RawVector().Data
+	quadric := mat.NewSymDense(3, []float64{
+		v[0], v[5], v[4],
+		v[5], v[1], v[3],
+		v[4], v[3], v[2],
+	})
+	linear := mat.NewVecDense(3, []float64{v[6], v[7], v[8]})
+
+	// the center is where the gradient vanishes, and the quadric scaled by
+	// the value there describes the ellipsoid around it
+	var center mat.VecDense
+	if err := center.SolveVec(quadric, linear); err != nil {
+		return fit, fmt.Errorf("failed to fit ellipsoid center: %w", err)
+	}
+	center.ScaleVec(-1, &center)
+	k := 1 + mat.Inner(&center, quadric, &center)
+
+	var eigen mat.EigenSym
+	if !eigen.Factorize(quadric, true) {
+		return fit, errors.New("failed to decompose the fitted ellipsoid")
+	}
+	values := eigen.Values(nil)
+	var vectors mat.Dense
+	eigen.VectorsTo(&vectors)
+	// the square root of the normalized quadric maps the ellipsoid onto the
+	// unit sphere, and dividing by its determinant's cube root onto a sphere
+	// of the same volume instead
+	roots := make([]float64, 3)
+	det := 1.0
+	for i, value := range values {
+		if value/k <= 0 {
+			return fit, errors.New("magnetometer samples do not determine an ellipsoid, rotate through more orientations")
+		}
+		roots[i] = math.Sqrt(value / k)
+		det *= roots[i]
+	}
+	// the values are in ascending order and the axes are inversely
+	// proportional to their roots
+	fit.Anisotropy = roots[2] / roots[0]
+	var correction mat.Dense
+	correction.Mul(&vectors, mat.NewDiagDense(3, roots))
+	correction.Mul(&correction, vectors.T())
+	correction.Scale(1/math.Cbrt(det), &correction)
+
+	for i, m := range []float64{mean.X, mean.Y, mean.Z} {
+		fit.Calibration.Offset[i] = m + scale*center.AtVec(i)
+		for j := 0; j < 3; j++ {
+			fit.Calibration.Matrix[i][j] = correction.At(i, j)
+		}
+	}
+
+	raw := make([]float64, len(samples))
+	corrected := make([]float64, len(samples))
+	for i, s := range samples {
+		raw[i] = s.Norm()
+		corrected[i] = fit.Calibration.apply(s).Norm()
+	}
+	_, fit.RawSpread, _ = relativeSpread(raw)
+	fit.FieldStrength, fit.Residual, fit.MaxResidual = relativeSpread(corrected)
+	return fit, nil
+}
+
+// relativeSpread returns the mean of values and their RMS and largest
+// deviation from it relative to it.
+func relativeSpread(values []float64) (mean, rms, largest float64) {
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	if mean == 0 {
+		return 0, 0, 0
+	}
+	for _, v := range values {
+		d := math.Abs(v-mean) / mean
+		rms += d * d
+		largest = math.Max(largest, d)
+	}
+	return mean, math.Sqrt(rms / float64(len(values))), largest
+}
+
+// magCalibrator collects the magnetometer samples of a calibration while it
+// is installed.
+type magCalibrator struct {
+	started time.Time
+	// device is whether the device runs a representative motion of its own
+	// in-run compass calibration alongside.
+	device bool
+
+	mu      sync.Mutex
+	samples []r3.Vector
+	seen    int
+}
+
+func (m *magCalibrator) update(field r3.Vector) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seen++
+	if len(m.samples) >= maxMagSamples {
+		return
+	}
+	if n := len(m.samples); n > 0 {
+		last := m.samples[n-1]
+		if field.Sub(last).Norm() < magSampleSpacing*last.Norm() {
+			return
+		}
+	}
+	m.samples = append(m.samples, field)
+}
+
+// snapshot returns the samples kept and the number seen.
+func (m *magCalibrator) snapshot() ([]r3.Vector, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]r3.Vector(nil), m.samples...), m.seen
+}
+
+// magCalibrationCommand implements the magnetometer calibration commands.
+// They are called with c.mu held.
+func (c *Compass) magCalibrationCommand(name string, cmd map[string]interface{}) (map[string]interface{}, error) {
+	calibrator, _ := c.magCal.Load().(*magCalibrator)
+	switch name {
+	case "start_mag_calibration":
+		if calibrator != nil {
+			return nil, errors.New("magnetometer calibration already running")
+		}
+		return c.startMagCalibration(cmd)
+	case "clear_mag_calibration":
+		c.magCorrection.Store((*MagCalibration)(nil))
+		c.correctedHeading.Store(math.NaN())
+		c.logger.Infow("cleared magnetometer calibration", "id", c.cfg.DeviceID)
+		return map[string]interface{}{"command": name}, nil
+	}
+	if calibrator == nil {
+		return nil, errors.New("no magnetometer calibration running")
+	}
+	switch name {
+	case "mag_calibration_status":
+		return magCalibrationStatus(calibrator), nil
+	case "cancel_mag_calibration":
+		c.magCal.Store((*magCalibrator)(nil))
+		if calibrator.device {
+			gen.DeleteIccRepMotionResult(c.device.StopRepresentativeMotion())
+		}
+		return map[string]interface{}{"command": name}, nil
+	default:
+		return c.finishMagCalibration(calibrator, cmd)
+	}
+}
+
+func (c *Compass) startMagCalibration(cmd map[string]interface{}) (map[string]interface{}, error) {
+	calibrator := &magCalibrator{started: time.Now()}
+	if device, _ := cmd["device"].(bool); device {
+		if !c.device.StartRepresentativeMotion() {
+			return nil, deviceError(c.device, ErrConfigRejected, "start representative motion")
+		}
+		calibrator.device = true
+	}
+	c.magCal.Store(calibrator)
+	c.logger.Infow("started magnetometer calibration", "id", c.cfg.DeviceID, "device", calibrator.device)
+	return map[string]interface{}{
+		"command": "start_mag_calibration",
+		"device":  calibrator.device,
+	}, nil
+}
+
+// magCalibrationStatus reports the progress of a calibration, guiding the
+// user to the orientations it still lacks.
+func magCalibrationStatus(calibrator *magCalibrator) map[string]interface{} {
+	samples, seen := calibrator.snapshot()
+	coverage := magCoverage(samples)
+	return map[string]interface{}{
+		"command":           "mag_calibration_status",
+		"elapsed_sec":       time.Since(calibrator.started).Seconds(),
+		"samples":           len(samples),
+		"samples_seen":      seen,
+		"coverage":          coverage.Fraction,
+		"band_coverage":     append([]float64(nil), coverage.Bands[:]...),
+		"uncovered":         vectorMap(coverage.Uncovered),
+		"hint":              coverageHint(coverage),
+		"ready":             len(samples) >= minMagSamples && coverage.Fraction >= minMagCoverage,
+		"device":            calibrator.device,
+		"min_samples":       minMagSamples,
+		"min_coverage":      minMagCoverage,
+		"samples_exhausted": len(samples) >= maxMagSamples,
+	}
+}
+
+// finishMagCalibration ends a calibration, fits the samples and applies the
+// correction as the "apply" argument says: "software" corrects the
+// magnetometer data and the heading computed from it, "device" stores the device's own in-run
+// compass calibration and "none" only reports the fit.
+func (c *Compass) finishMagCalibration(calibrator *magCalibrator, cmd map[string]interface{}) (map[string]interface{}, error) {
+	apply := "software"
+	if v, ok := cmd["apply"].(string); ok {
+		apply = v
+	}
+	force, _ := cmd["force"].(bool)
+	switch apply {
+	case "software", "none":
+	case "device":
+		if !calibrator.device {
+			return nil, errors.New(`apply "device" needs the calibration started with "device": true`)
+		}
+	default:
+		return nil, fmt.Errorf(`unknown apply %q, expected "software", "device" or "none"`, apply)
+	}
+
+	samples, _ := calibrator.snapshot()
+	coverage := magCoverage(samples)
+	if coverage.Fraction < minMagCoverage && !force {
+		return nil, fmt.Errorf("only %.0f%% of directions covered, need %.0f%%: %s",
+			100*coverage.Fraction, 100*minMagCoverage, coverageHint(coverage))
+	}
+	fit, err := fitEllipsoid(samples)
+	if err != nil {
+		return nil, err
+	}
+	if apply == "software" && fit.Quality() == "poor" && !force {
+		return nil, fmt.Errorf("fit is poor with a residual of %.1f%%, not applying it", 100*fit.Residual)
+	}
+
+	result := map[string]interface{}{
+		"command":              "finish_mag_calibration",
+		"apply":                apply,
+		"samples":              len(samples),
+		"coverage":             coverage.Fraction,
+		"calibration":          fit.Calibration.toMap(),
+		"field_strength":       fit.FieldStrength,
+		"raw_spread_percent":   100 * fit.RawSpread,
+		"residual_percent":     100 * fit.Residual,
+		"max_residual_percent": 100 * fit.MaxResidual,
+		"anisotropy":           fit.Anisotropy,
+		"quality":              fit.Quality(),
+	}
+	if calibrator.device {
+		icc := c.device.StopRepresentativeMotion()
+		defer gen.DeleteIccRepMotionResult(icc)
+		// the representative motion is over, but the samples are kept for
+		// another apply until the device takes its result
+		calibrator.device = false
+		status := gen.IccStatus(icc)
+		result["device_result"] = map[string]interface{}{
+			"ddt_accuracy": gen.IccDdtAccuracy(icc),
+			"dimension":    gen.IccDimension(icc),
+			"status":       status,
+		}
+		if apply == "device" {
+			// the device warns about a disturbed field or too little data
+			// in the representative motion
+			if status&(gen.XISF_ddtWarning|gen.XISF_notEnoughData) != 0 {
+				return nil, fmt.Errorf("device rejected the representative motion with status %d, "+
+					"the samples are kept to apply in software: %w", status, ErrConfigRejected)
+			}
+			if !c.device.StoreIccResults() {
+				return nil, deviceError(c.device, ErrConfigRejected,
+					"store in-run compass calibration, the samples are kept to apply in software")
+			}
+		}
+	}
+	c.magCal.Store((*magCalibrator)(nil))
+	if apply == "software" {
+		calibration := fit.Calibration
+		c.magCorrection.Store(&calibration)
+		c.correctedHeading.Store(math.NaN())
+	}
+	c.logger.Infow("finished magnetometer calibration",
+		"id", c.cfg.DeviceID,
+		"apply", apply,
+		"quality", fit.Quality(),
+		"residual", fit.Residual,
+		"coverage", coverage.Fraction,
+	)
+	return result, nil
+}
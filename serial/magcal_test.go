@@ -0,0 +1,209 @@
+package serial
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/geo/r3"
+)
+
+// sphereDirections returns n directions spread evenly over the unit sphere.
+func sphereDirections(n int) []r3.Vector {
+	directions := make([]r3.Vector, n)
+	golden := math.Pi * (3 - math.Sqrt(5))
+	for i := range directions {
+		z := 1 - 2*(float64(i)+0.5)/float64(n)
+		r := math.Sqrt(1 - z*z)
+		sin, cos := math.Sincos(golden * float64(i))
+		directions[i] = r3.Vector{X: r * cos, Y: r * sin, Z: z}
+	}
+	return directions
+}
+
+func TestFitEllipsoid(t *testing.T) {
+	identity := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	for _, tc := range []struct {
+		name string
+		// the samples are a field of strength 50 in directions, distorted
+		// by softIron, shifted by offset and with relative noise added
+		directions []r3.Vector
+		softIron   [3][3]float64
+		offset     r3.Vector
+		noise      float64
+		wantErr    bool
+		// tolerance is how far the fitted offset may be off
+		tolerance   float64
+		wantQuality string
+	}{
+		{
+			name:        "hard iron",
+			directions:  sphereDirections(500),
+			softIron:    identity,
+			offset:      r3.Vector{X: 100, Y: -50, Z: 30},
+			tolerance:   1e-6,
+			wantQuality: "good",
+		},
+		{
+			name:        "hard and soft iron",
+			directions:  sphereDirections(500),
+			softIron:    [3][3]float64{{1.2, 0.1, 0}, {0.1, 0.9, 0.05}, {0, 0.05, 1}},
+			offset:      r3.Vector{X: 20, Y: 10, Z: -40},
+			tolerance:   1e-6,
+			wantQuality: "good",
+		},
+		{
+			name:        "noisy",
+			directions:  sphereDirections(2000),
+			softIron:    [3][3]float64{{1.1, 0, 0.05}, {0, 1, 0}, {0.05, 0, 0.95}},
+			offset:      r3.Vector{X: -15, Y: 5, Z: 25},
+			noise:       0.005,
+			tolerance:   0.5,
+			wantQuality: "good",
+		},
+		{
+			name:        "very noisy",
+			directions:  sphereDirections(2000),
+			softIron:    identity,
+			noise:       0.1,
+			tolerance:   5,
+			wantQuality: "poor",
+		},
+		{
+			name:       "too few samples",
+			directions: sphereDirections(minMagSamples - 1),
+			softIron:   identity,
+			wantErr:    true,
+		},
+		{
+			name:       "no variation",
+			directions: make([]r3.Vector, minMagSamples),
+			softIron:   identity,
+			offset:     r3.Vector{X: 1},
+			wantErr:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+			distortion := MagCalibration{Matrix: tc.softIron}
+			samples := make([]r3.Vector, len(tc.directions))
+			for i, d := range tc.directions {
+				field := d.Mul(50 * (1 + tc.noise*rng.NormFloat64()))
+				samples[i] = distortion.apply(field).Add(tc.offset)
+			}
+
+			fit, err := fitEllipsoid(samples)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("fit succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q := fit.Quality(); q != tc.wantQuality {
+				t.Errorf("Quality() = %q with residual %v, want %q", q, fit.Residual, tc.wantQuality)
+			}
+			offset := r3.Vector{X: fit.Calibration.Offset[0], Y: fit.Calibration.Offset[1], Z: fit.Calibration.Offset[2]}
+			if d := offset.Sub(tc.offset).Norm(); d > tc.tolerance {
+				t.Errorf("Offset = %v, want %v", offset, tc.offset)
+			}
+			if tc.noise > 0 {
+				return
+			}
+			// the correction undoes the soft iron up to the scale that
+			// keeps its determinant 1
+			scale := math.Cbrt(det3(tc.softIron))
+			for i, d := range []r3.Vector{{X: 1}, {Y: 1}, {Z: 1}} {
+				got := fit.Calibration.apply(distortion.apply(d).Add(offset))
+				if diff := got.Sub(d.Mul(scale)).Norm(); diff > 1e-6 {
+					t.Errorf("corrected axis %d is %v, want %v", i, got, d.Mul(scale))
+				}
+			}
+			if fit.Residual > 1e-9 {
+				t.Errorf("Residual = %v, want 0", fit.Residual)
+			}
+		})
+	}
+}
+
+func det3(m [3][3]float64) float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+func TestMagneticYaw(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		yaw         float64
+		roll, pitch float64
+	}{
+		{name: "north", yaw: 0},
+		{name: "west", yaw: 90},
+		{name: "south", yaw: 180},
+		{name: "east", yaw: -90},
+		{name: "rolled", yaw: 30, roll: 20},
+		{name: "pitched", yaw: -120, pitch: -25},
+		{name: "rolled and pitched", yaw: 60, roll: -15, pitch: 35},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// the field dipping 60 degrees in the earth frame, seen by a
+			// sensor at yaw, pitch and roll, applied in that order
+			earth := r3.Vector{X: 0.5, Z: -math.Sqrt(3) / 2}
+			field := rotateX(rotateY(rotateZ(earth, -tc.yaw), -tc.pitch), -tc.roll)
+			got := magneticYaw(field, tc.roll, tc.pitch)
+			if d := math.Abs(wrapAngle((got - tc.yaw) * math.Pi / 180)); d > 1e-9 {
+				t.Errorf("magneticYaw = %v, want %v", got, tc.yaw)
+			}
+		})
+	}
+}
+
+// rotateX, rotateY and rotateZ rotate v by degrees about an axis.
+func rotateX(v r3.Vector, degrees float64) r3.Vector {
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return r3.Vector{X: v.X, Y: v.Y*cos - v.Z*sin, Z: v.Y*sin + v.Z*cos}
+}
+
+func rotateY(v r3.Vector, degrees float64) r3.Vector {
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return r3.Vector{X: v.X*cos + v.Z*sin, Y: v.Y, Z: -v.X*sin + v.Z*cos}
+}
+
+func rotateZ(v r3.Vector, degrees float64) r3.Vector {
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return r3.Vector{X: v.X*cos - v.Y*sin, Y: v.X*sin + v.Y*cos, Z: v.Z}
+}
+
+func TestCorrectedCompassHeading(t *testing.T) {
+	distortion := MagCalibration{Matrix: [3][3]float64{{1.3, 0, 0}, {0, 0.8, 0}, {0, 0, 1}}}
+	offset := r3.Vector{X: 20, Y: -35, Z: 10}
+	correction := MagCalibration{
+		Offset: [3]float64{offset.X, offset.Y, offset.Z},
+		Matrix: [3][3]float64{{1 / 1.3, 0, 0}, {0, 1 / 0.8, 0}, {0, 0, 1}},
+	}
+	for _, tc := range []struct {
+		name        string
+		yaw         float64
+		roll, pitch float64
+		want        float64
+	}{
+		{name: "north", yaw: 0, want: 0},
+		{name: "west", yaw: 90, want: 270},
+		{name: "east", yaw: -90, want: 90},
+		{name: "south", yaw: 180, want: 180},
+		{name: "tilted", yaw: 45, roll: 10, pitch: -20, want: 315},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			earth := r3.Vector{X: 25, Z: -40}
+			field := rotateX(rotateY(rotateZ(earth, -tc.yaw), -tc.pitch), -tc.roll)
+			measured := distortion.apply(field).Add(offset)
+			got := compassHeading(magneticYaw(correction.apply(measured), tc.roll, tc.pitch))
+			if d := math.Abs(wrapAngle((got - tc.want) * math.Pi / 180)); d > 1e-9 {
+				t.Errorf("corrected heading = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package serial
+
+import (
+	"math"
+	"time"
+
+	"github.com/golang/geo/r3"
+	rutils "go.viam.com/rdk/utils"
+)
+
+// MagneticDisturbanceConfig tunes a MagneticDisturbanceDetector. Zero values
+// are replaced by the defaults below.
+type MagneticDisturbanceConfig struct {
+	// Window is the span of samples compared against each other.
+	Window time.Duration
+	// NormTolerance is the allowed relative deviation of the field norm from
+	// its reference (0.1 means 10%).
+	NormTolerance float64
+	// DipTolerance is the allowed deviation of the dip angle in degrees.
+	DipTolerance float64
+	// YawTolerance is the allowed disagreement in degrees between the
+	// gyro-integrated and magnetometer-derived yaw change over Window.
+	YawTolerance float64
+	// ReacquireAfter is how long the norm or dip may be off their references
+	// while the yaw checks out before the references are taken anew, e.g.
+	// after starting in a disturbed field.
+	ReacquireAfter time.Duration
+}
+
+const (
+	defaultMagneticWindow        = 2 * time.Second
+	defaultMagneticNormTolerance = 0.1
+	defaultMagneticDipTolerance  = 5.0
+	defaultMagneticYawTolerance  = 10.0
+	defaultMagneticReacquire     = 30 * time.Second
+
+	// referenceGain is how quickly the undisturbed norm and dip references
+	// follow slow changes, e.g. when the robot drives to another location.
+	referenceGain = 0.01
+)
+
+// MagneticSample is one set of calibrated measurements in the sensor frame.
+type MagneticSample struct {
+	Time time.Duration
+	// Magnetometer is the calibrated magnetic field in arbitrary units.
+	Magnetometer r3.Vector
+	// Accelerometer is the calibrated acceleration in m/s^2.
+	Accelerometer r3.Vector
+	// Gyroscope is the calibrated rate of turn in rad/s.
+	Gyroscope r3.Vector
+	// Roll and Pitch are the filter's inclination estimate in degrees.
+	Roll, Pitch float64
+}
+
+// MagneticDisturbance is the detector's verdict for a single sample.
+type MagneticDisturbance struct {
+	// Score is 0 for a clean field and grows to 1 when at least one of the
+	// checks is twice its tolerance away from the reference.
+	Score float64
+	// HeadingTrusted is false while any check exceeds its tolerance.
+	HeadingTrusted bool
+	// NormDeviation is the relative deviation of the field norm.
+	NormDeviation float64
+	// DipDeviation is the deviation of the dip angle in degrees.
+	DipDeviation float64
+	// YawDeviation is the gyro/magnetometer yaw disagreement in degrees.
+	YawDeviation float64
+}
+
+type magneticWindowEntry struct {
+	time     time.Duration
+	magYaw   float64
+	gyroYaw  float64
+	norm     float64
+	dipAngle float64
+}
+
+// MagneticDisturbanceDetector flags samples whose magnetic field is unlikely
+// to give a good heading by watching the field norm, the dip angle and the
+// consistency between gyro-integrated and magnetometer-derived yaw.
+type MagneticDisturbanceDetector struct {
+	cfg MagneticDisturbanceConfig
+
+	window  []magneticWindowEntry
+	gyroYaw float64
+	magYaw  float64
+
+	haveReference bool
+	refNorm       float64
+	refDip        float64
+	// offSince is when the norm or dip last went off their references while
+	// the yaw checked out, and off whether they still are.
+	offSince time.Duration
+	off      bool
+}
+
+// NewMagneticDisturbanceDetector returns a detector with the given tuning.
+func NewMagneticDisturbanceDetector(cfg MagneticDisturbanceConfig) *MagneticDisturbanceDetector {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultMagneticWindow
+	}
+	if cfg.NormTolerance <= 0 {
+		cfg.NormTolerance = defaultMagneticNormTolerance
+	}
+	if cfg.DipTolerance <= 0 {
+		cfg.DipTolerance = defaultMagneticDipTolerance
+	}
+	if cfg.YawTolerance <= 0 {
+		cfg.YawTolerance = defaultMagneticYawTolerance
+	}
+	if cfg.ReacquireAfter <= 0 {
+		cfg.ReacquireAfter = defaultMagneticReacquire
+	}
+	return &MagneticDisturbanceDetector{cfg: cfg}
+}
+
+// Update feeds the next sample into the window and returns its verdict.
+func (d *MagneticDisturbanceDetector) Update(s MagneticSample) MagneticDisturbance {
+	roll := rutils.DegToRad(s.Roll)
+	pitch := rutils.DegToRad(s.Pitch)
+
+	entry := magneticWindowEntry{
+		time:     s.Time,
+		norm:     s.Magnetometer.Norm(),
+		dipAngle: dipAngle(s.Magnetometer, s.Accelerometer),
+	}
+
+	// the field is levelled using roll and pitch only so the resulting
+	// yaw is independent of the filter's own (possibly disturbed) heading
+	magYaw := rutils.DegToRad(magneticYaw(s.Magnetometer, s.Roll, s.Pitch))
+	sinR, cosR := math.Sincos(roll)
+	cosP := math.Cos(pitch)
+
+	if n := len(d.window); n > 0 {
+		prev := d.window[n-1]
+		dt := (s.Time - prev.time).Seconds()
+		if dt < 0 || dt > d.cfg.Window.Seconds() {
+			// time went backwards or a long gap; start over
+			d.window = d.window[:0]
+		} else {
+			// earth-frame yaw rate from body rates for a ZYX euler sequence
+			if math.Abs(cosP) > 1e-3 {
+				yawRate := (s.Gyroscope.Y*sinR + s.Gyroscope.Z*cosR) / cosP
+				d.gyroYaw += yawRate * dt
+			}
+			d.magYaw += wrapAngle(magYaw - d.magYaw)
+		}
+	}
+	if len(d.window) == 0 {
+		d.gyroYaw = 0
+		d.magYaw = magYaw
+	}
+	entry.gyroYaw = d.gyroYaw
+	entry.magYaw = d.magYaw
+	d.window = append(d.window, entry)
+
+	for len(d.window) > 1 && s.Time-d.window[0].time > d.cfg.Window {
+		d.window = d.window[1:]
+	}
+
+	if !d.haveReference {
+		d.refNorm = entry.norm
+		d.refDip = entry.dipAngle
+		d.haveReference = entry.norm > 0
+	}
+
+	var result MagneticDisturbance
+	if d.refNorm > 0 {
+		result.NormDeviation = math.Abs(entry.norm-d.refNorm) / d.refNorm
+	}
+	result.DipDeviation = math.Abs(entry.dipAngle - d.refDip)
+
+	first := d.window[0]
+	gyroDelta := entry.gyroYaw - first.gyroYaw
+	magDelta := entry.magYaw - first.magYaw
+	result.YawDeviation = math.Abs(rutils.RadToDeg(magDelta - gyroDelta))
+
+	ratio := math.Max(
+		result.NormDeviation/d.cfg.NormTolerance,
+		math.Max(result.DipDeviation/d.cfg.DipTolerance, result.YawDeviation/d.cfg.YawTolerance),
+	)
+	result.Score = math.Min(1, ratio/2)
+	result.HeadingTrusted = ratio <= 1
+
+	if result.HeadingTrusted {
+		d.refNorm += referenceGain * (entry.norm - d.refNorm)
+		d.refDip += referenceGain * (entry.dipAngle - d.refDip)
+	}
+
+	// a field that stays off the references while the magnetometer turns
+	// with the gyroscope is steady, so rather than a passing disturbance the
+	// references are wrong, e.g. taken in a disturbed field at startup
+	switch {
+	case result.HeadingTrusted || result.YawDeviation > d.cfg.YawTolerance:
+		d.off = false
+	case !d.off:
+		d.off, d.offSince = true, s.Time
+	case s.Time-d.offSince >= d.cfg.ReacquireAfter:
+		d.refNorm, d.refDip = entry.norm, entry.dipAngle
+		d.off = false
+	}
+	return result
+}
+
+// dipAngle returns the inclination of the field below the horizon in degrees,
+// using the accelerometer as the up direction.
+func dipAngle(mag, acc r3.Vector) float64 {
+	mNorm, aNorm := mag.Norm(), acc.Norm()
+	if mNorm == 0 || aNorm == 0 {
+		return 0
+	}
+	sin := -mag.Dot(acc) / (mNorm * aNorm)
+	return rutils.RadToDeg(math.Asin(math.Max(-1, math.Min(1, sin))))
+}
+
+// wrapAngle maps an angle in radians onto (-pi, pi].
+func wrapAngle(a float64) float64 {
+	return math.Atan2(math.Sin(a), math.Cos(a))
+}
@@ -0,0 +1,152 @@
+package serial
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/r3"
+	rutils "go.viam.com/rdk/utils"
+)
+
+// magneticField returns the sample of a level sensor at t, turned yaw
+// degrees from north, in a field of strength 50*scale dipping dip degrees,
+// while the gyroscope measures rate degrees per second about z.
+func magneticField(t time.Duration, yaw, dip, scale, rate float64) MagneticSample {
+	sinY, cosY := math.Sincos(rutils.DegToRad(-yaw))
+	sinD, cosD := math.Sincos(rutils.DegToRad(dip))
+	horizontal := 50 * scale * cosD
+	return MagneticSample{
+		Time:          t,
+		Magnetometer:  r3.Vector{X: horizontal * cosY, Y: horizontal * sinY, Z: -50 * scale * sinD},
+		Accelerometer: r3.Vector{Z: 9.81},
+		Gyroscope:     r3.Vector{Z: rutils.DegToRad(rate)},
+	}
+}
+
+func TestMagneticDisturbanceDetector(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		cfg      MagneticDisturbanceConfig
+		duration time.Duration
+		sample   func(t time.Duration) MagneticSample
+		want     MagneticDisturbance
+	}{
+		{
+			name:     "clean",
+			duration: 3 * time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{HeadingTrusted: true},
+		},
+		{
+			name:     "small norm change",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				if t >= time.Second {
+					return magneticField(t, 0, 60, 1.05, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 0.25, HeadingTrusted: true, NormDeviation: 0.05},
+		},
+		{
+			name:     "norm disturbed",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				if t >= time.Second {
+					return magneticField(t, 0, 60, 1.3, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 1, NormDeviation: 0.3},
+		},
+		{
+			name:     "small dip change",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				if t >= time.Second {
+					return magneticField(t, 0, 62, 1, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 0.2, HeadingTrusted: true, DipDeviation: 2},
+		},
+		{
+			name:     "dip disturbed",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				if t >= time.Second {
+					return magneticField(t, 0, 70, 1, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 1, DipDeviation: 10},
+		},
+		{
+			name:     "turning with the gyroscope",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				return magneticField(t, 30*t.Seconds(), 60, 1, 30)
+			},
+			want: MagneticDisturbance{HeadingTrusted: true},
+		},
+		{
+			name:     "turning without the gyroscope",
+			duration: time.Second,
+			sample: func(t time.Duration) MagneticSample {
+				return magneticField(t, 15*t.Seconds(), 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 0.75, YawDeviation: 15},
+		},
+		{
+			name:     "disturbed reference not yet reacquired",
+			cfg:      MagneticDisturbanceConfig{ReacquireAfter: time.Second},
+			duration: 500 * time.Millisecond,
+			sample: func(t time.Duration) MagneticSample {
+				if t == 0 {
+					return magneticField(t, 0, 60, 1.25, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{Score: 1, NormDeviation: 0.2},
+		},
+		{
+			name:     "disturbed reference reacquired",
+			cfg:      MagneticDisturbanceConfig{ReacquireAfter: time.Second},
+			duration: 1500 * time.Millisecond,
+			sample: func(t time.Duration) MagneticSample {
+				if t == 0 {
+					return magneticField(t, 0, 60, 1.25, 0)
+				}
+				return magneticField(t, 0, 60, 1, 0)
+			},
+			want: MagneticDisturbance{HeadingTrusted: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewMagneticDisturbanceDetector(tc.cfg)
+			var got MagneticDisturbance
+			for at := time.Duration(0); at <= tc.duration; at += 10 * time.Millisecond {
+				got = d.Update(tc.sample(at))
+			}
+			if got.HeadingTrusted != tc.want.HeadingTrusted {
+				t.Errorf("HeadingTrusted = %v, want %v", got.HeadingTrusted, tc.want.HeadingTrusted)
+			}
+			for _, field := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"Score", got.Score, tc.want.Score},
+				{"NormDeviation", got.NormDeviation, tc.want.NormDeviation},
+				{"DipDeviation", got.DipDeviation, tc.want.DipDeviation},
+				{"YawDeviation", got.YawDeviation, tc.want.YawDeviation},
+			} {
+				if math.Abs(field.got-field.want) > 1e-6 {
+					t.Errorf("%s = %v, want %v", field.name, field.got, field.want)
+				}
+			}
+		})
+	}
+}
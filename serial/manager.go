@@ -0,0 +1,310 @@
+package serial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// devices is the process-wide device manager. All Compasses share its
+// XsControl so that several devices, or several components using the same
+// device, can run in one process.
+var devices = &deviceManager{handles: make(map[string]*deviceHandle)}
+
+// deviceManager owns the XsControl and the devices opened on it.
+type deviceManager struct {
+	mu      sync.Mutex
+	control gen.XsControl
+	handles map[string]*deviceHandle
+}
+
+// deviceHandle is an opened device, shared by the Compasses using it and
+// closed when the last of them is.
+type deviceHandle struct {
+	id       string
+	port     string
+	baudRate gen.XsBaudRate
+	discover DiscoverOptions
+	device   gen.XSDevice
+
+	refs      int
+	users     map[*Compass]struct{}
+	connected bool
+	// opened is closed once the Compass that reserved the handle opened
+	// the device. A handle whose open failed is removed before it is
+	// closed.
+	opened chan struct{}
+	// resetting is non-zero while a user resets the device, during which
+	// its port may briefly disappear.
+	resetting int
+	closeCh   chan struct{}
+}
+
+// lookup returns the handle of an already opened device with one more
+// reference, or nil. A device another Compass is opening is waited for.
+func (m *deviceManager) lookup(ctx context.Context, deviceID string, user *Compass) (gen.XsControl, *deviceHandle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h, err := m.existing(ctx, deviceID, user)
+	if h == nil {
+		return nil, nil, err
+	}
+	return m.control, h, nil
+}
+
+// existing returns the handle of deviceID with one more reference, or nil
+// when the device is not open. While another Compass opens the device it
+// waits without m.mu held. It must be called with m.mu held.
+func (m *deviceManager) existing(ctx context.Context, deviceID string, user *Compass) (*deviceHandle, error) {
+	for {
+		h, ok := m.handles[deviceID]
+		if !ok {
+			return nil, nil
+		}
+		select {
+		case <-h.opened:
+			h.refs++
+			h.users[user] = struct{}{}
+			return h, nil
+		default:
+		}
+		m.mu.Unlock()
+		select {
+		case <-h.opened:
+			m.mu.Lock()
+		case <-ctx.Done():
+			m.mu.Lock()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// open opens the device with the given ID on port and returns its handle.
+// If another Compass opened the device in the meantime its handle is
+// returned instead. The handle is reserved while the port is opened, which
+// may take a baud rate scan, so that m.mu is not held meanwhile and others
+// wait for the device rather than open it too. When ctx is done before the
+// port is open, the port is closed again once the SDK is done opening it.
+func (m *deviceManager) open(
+	ctx context.Context,
+	deviceID, port string,
+	baudRate gen.XsBaudRate,
+	discover DiscoverOptions,
+	user *Compass,
+) (gen.XsControl, *deviceHandle, error) {
+	m.mu.Lock()
+	if h, err := m.existing(ctx, deviceID, user); h != nil || err != nil {
+		control := m.control
+		m.mu.Unlock()
+		if err != nil {
+			return nil, nil, err
+		}
+		return control, h, nil
+	}
+	if m.control == nil {
+		m.control = gen.XsControlConstruct()
+	}
+	control := m.control
+	h := &deviceHandle{
+		id:       deviceID,
+		port:     port,
+		baudRate: baudRate,
+		discover: discover,
+		refs:     1,
+		users:    map[*Compass]struct{}{user: {}},
+		opened:   make(chan struct{}),
+		closeCh:  make(chan struct{}),
+	}
+	m.handles[deviceID] = h
+	m.mu.Unlock()
+
+	var device gen.XSDevice
+	err := callContext(ctx, "open port "+port, func() error {
+		var err error
+		device, err = openDevice(control, port, baudRate, deviceID)
+		return err
+	}, func(err error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if err == nil {
+			m.closePort(port)
+		}
+		m.unreserve(h)
+	})
+	var ctxErr *contextError
+	if errors.As(err, &ctxErr) {
+		return nil, nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.unreserve(h)
+		return nil, nil, err
+	}
+	h.device = device
+	h.connected = true
+	close(h.opened)
+	go m.watch(h)
+	return control, h, nil
+}
+
+// unreserve removes the handle of a device which failed to open. It must
+// be called with m.mu held.
+func (m *deviceManager) unreserve(h *deviceHandle) {
+	delete(m.handles, h.id)
+	close(h.closeCh)
+	close(h.opened)
+	m.destructIfUnused()
+}
+
+// release drops a reference to h, closing the device once no Compass uses
+// it and the XsControl once no device is open.
+func (m *deviceManager) release(h *deviceHandle, user *Compass) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(h.users, user)
+	m.unref(h)
+}
+
+// unref drops a reference to h, closing the device once it is unused and
+// the XsControl once no device is open. It must be called with m.mu held.
+func (m *deviceManager) unref(h *deviceHandle) {
+	h.refs--
+	if h.refs > 0 {
+		return
+	}
+	close(h.closeCh)
+	if h.connected {
+		m.closePort(h.port)
+		h.connected = false
+	}
+	delete(m.handles, h.id)
+	m.destructIfUnused()
+}
+
+// destructIfUnused destructs the XsControl once no device is open on it. It
+// must be called with m.mu held.
+func (m *deviceManager) destructIfUnused() {
+	if len(m.handles) == 0 && m.control != nil {
+		m.control.Destruct()
+		m.control = nil
+	}
+}
+
+// beginReset stops h from being treated as unplugged while it resets.
+func (m *deviceManager) beginReset(h *deviceHandle) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h.resetting++
+}
+
+// endReset ends beginReset.
+func (m *deviceManager) endReset(h *deviceHandle) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h.resetting--
+}
+
+// reopen finds the device of h again after it was reset, reopening its port
+// if the SDK closed it, and returns the device it came back as and its port.
+func (m *deviceManager) reopen(h *deviceHandle) (gen.XSDevice, string, error) {
+	deadline := time.Now().Add(reconnectTimeout)
+	for {
+		device, port, err := m.tryReopen(h)
+		if device != nil || err != nil {
+			return device, port, err
+		}
+		if time.Now().After(deadline) {
+			return nil, port, fmt.Errorf("device %s did not come back on %q after reset: %w", h.id, port, ErrTimeout)
+		}
+		time.Sleep(reconnectInterval)
+	}
+}
+
+// tryReopen looks for the device of h once, opening its port if the device
+// is not there. It returns a nil device when it is not back yet.
+func (m *deviceManager) tryReopen(h *deviceHandle) (gen.XSDevice, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case <-h.closeCh:
+		return nil, h.port, errHandleClosed
+	default:
+	}
+	devID := gen.NewXSDeviceId()
+	defer gen.DeleteXSDeviceId(devID)
+	devIDStr := gen.NewXSString(h.id)
+	defer gen.DeleteXSString(devIDStr)
+	devID.FromString(devIDStr)
+
+	device := m.control.Device(devID)
+	if device.Swigcptr() == 0 {
+		// opening fails while the SDK still holds the port, which is fine
+		pathStr := gen.NewXSString(h.port)
+		defer gen.DeleteXSString(pathStr)
+		m.control.OpenPort(pathStr, h.baudRate)
+		if device = m.control.Device(devID); device.Swigcptr() == 0 {
+			return nil, h.port, nil
+		}
+	}
+	h.device = device
+	h.connected = true
+	return device, h.port, nil
+}
+
+// notifyAttached tells the users of h other than except that its device is
+// now device on port. It must be called without m.mu held.
+func (m *deviceManager) notifyAttached(h *deviceHandle, device gen.XSDevice, port string, except *Compass) {
+	for _, c := range m.users(h) {
+		if c != except {
+			c.attached(device, port)
+		}
+	}
+}
+
+// users returns the Compasses using h.
+func (m *deviceManager) users(h *deviceHandle) []*Compass {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	users := make([]*Compass, 0, len(h.users))
+	for c := range h.users {
+		users = append(users, c)
+	}
+	return users
+}
+
+// closePort closes port. It must be called with m.mu held.
+func (m *deviceManager) closePort(port string) {
+	pathStr := gen.NewXSString(port)
+	defer gen.DeleteXSString(pathStr)
+	m.control.ClosePort(pathStr)
+}
+
+// errHandleClosed is returned when reattaching a device no Compass uses
+// anymore.
+var errHandleClosed = errors.New("device closed")
+
+// handleContext returns a context which is done once h is closed.
+func handleContext(h *deviceHandle) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-h.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// state returns the current device of h, its port and whether it is
+// connected.
+func (m *deviceManager) state(h *deviceHandle) (gen.XSDevice, string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return h.device, h.port, h.connected
+}
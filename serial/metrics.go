@@ -0,0 +1,207 @@
+package serial
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edaniels/golog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricLabels label every metric with the device's serial number and the
+// name of the component using it.
+var metricLabels = []string{"serial", "component"}
+
+func newMetricDesc(name, help string, labels ...string) *prometheus.Desc {
+	return prometheus.NewDesc("xsens_"+name, help, append(append([]string(nil), metricLabels...), labels...), nil)
+}
+
+var (
+	packetsReceivedDesc = newMetricDesc("packets_received_total", "Packets received from the device.")
+	packetRateDesc      = newMetricDesc("packet_rate_hz", "Packets received per second over the latest second.")
+	packetsLostDesc     = newMetricDesc("packets_lost_total", "Packets missing from the packet counter.")
+	packetsDroppedDesc  = newMetricDesc("packets_dropped_total",
+		"Packets dropped by the native callback buffer or reported missed by the SDK.", "where")
+	counterGapsDesc      = newMetricDesc("packet_counter_gaps_total", "Gaps in the packet counter.")
+	reconnectsDesc       = newMetricDesc("reconnects_total", "Times the device was reconnected to.")
+	connectedDesc        = newMetricDesc("connected", "Whether the device is connected.")
+	stalenessDesc        = newMetricDesc("last_packet_age_seconds", "Time since the latest packet.")
+	temperatureDesc      = newMetricDesc("temperature_celsius", "Temperature of the device.")
+	filterValidDesc      = newMetricDesc("filter_valid", "Whether the device's orientation filter is valid.")
+	magneticDesc         = newMetricDesc("magnetic_disturbance", "Magnetic disturbance score, 0 clean to 1 disturbed.")
+	headingTrustedDesc   = newMetricDesc("heading_trusted", "Whether the heading is trusted.")
+	saturatedSamplesDesc = newMetricDesc("saturated_samples_total", "Samples in which a sensor clipped.")
+)
+
+// metrics exports the metrics of every live Compass.
+var metrics = newMetricsCollector()
+
+// metricsRegistry holds only the Compass metrics, so they can be served on
+// their own.
+var metricsRegistry = func() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics)
+	return registry
+}()
+
+// MetricsHandler serves the metrics of all Compasses in the process in the
+// Prometheus text format.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{})
+}
+
+// metricsCollector is a prometheus.Collector over the registered Compasses.
+type metricsCollector struct {
+	mu        sync.Mutex
+	compasses map[*Compass]struct{}
+}
+
+func newMetricsCollector() *metricsCollector {
+	return &metricsCollector{compasses: make(map[*Compass]struct{})}
+}
+
+func (m *metricsCollector) register(c *Compass) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.compasses[c] = struct{}{}
+}
+
+func (m *metricsCollector) unregister(c *Compass) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.compasses, c)
+}
+
+// Describe implements prometheus.Collector.
+func (m *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		packetsReceivedDesc, packetRateDesc, packetsLostDesc, packetsDroppedDesc, counterGapsDesc,
+		reconnectsDesc, connectedDesc, stalenessDesc, temperatureDesc, filterValidDesc, magneticDesc,
+		headingTrustedDesc, saturatedSamplesDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector. m.mu is held throughout, so Close,
+// which unregisters a Compass before freeing its callback handler, waits for
+// the scrape.
+func (m *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for c := range m.compasses {
+		c.collectMetrics(ch)
+	}
+}
+
+// collectMetrics reads only what can be read without c.mu, so a scrape does
+// not wait for a long running command.
+func (c *Compass) collectMetrics(ch chan<- prometheus.Metric) {
+	select {
+	case <-c.closeCh:
+		return
+	default:
+	}
+	labels := []string{c.cfg.DeviceID, c.cfg.Name}
+	counter := func(desc *prometheus.Desc, value float64, extra ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, append(labels, extra...)...)
+	}
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+	boolValue := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+
+	stats := c.packets.Stats(c.callback)
+	counter(packetsReceivedDesc, float64(stats.Received))
+	counter(packetsLostDesc, float64(stats.Lost))
+	counter(packetsDroppedDesc, float64(stats.DroppedNative), "native")
+	counter(packetsDroppedDesc, float64(stats.MissedSDK), "sdk")
+	counter(counterGapsDesc, float64(stats.Gaps))
+	link, _ := c.link.Load().(linkState)
+	counter(reconnectsDesc, float64(link.reconnects))
+	gauge(connectedDesc, boolValue(link.connected))
+
+	gauge(packetRateDesc, c.packets.Rate())
+	if last := c.packets.LastPacket(); !last.IsZero() {
+		gauge(stalenessDesc, time.Since(last).Seconds())
+	}
+
+	if status, ok := c.status.Load().(deviceStatus); ok {
+		if !math.IsNaN(status.temperature) {
+			gauge(temperatureDesc, status.temperature)
+		}
+		if status.hasStatus {
+			gauge(filterValidDesc, boolValue(status.status&statusOrientationValid != 0))
+		}
+	}
+	if magnetic := c.magnetic.Load().(MagneticDisturbance); !math.IsNaN(magnetic.Score) {
+		gauge(magneticDesc, magnetic.Score)
+		gauge(headingTrustedDesc, boolValue(magnetic.HeadingTrusted))
+	}
+	counter(saturatedSamplesDesc, float64(c.clipping.Counts().Saturated))
+}
+
+// metricsServers are the HTTP servers serving MetricsHandler, by address,
+// shared by the Compasses configured with the same address.
+var metricsServers = struct {
+	sync.Mutex
+	servers map[string]*metricsServer
+}{servers: make(map[string]*metricsServer)}
+
+type metricsServer struct {
+	server *http.Server
+	refs   int
+}
+
+// startMetricsServer serves the metrics at addr until stopMetricsServer is
+// called as often as it was.
+func startMetricsServer(addr string, logger golog.Logger) error {
+	metricsServers.Lock()
+	defer metricsServers.Unlock()
+	if s, ok := metricsServers.servers[addr]; ok {
+		s.refs++
+		return nil
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", MetricsHandler())
+	s := &metricsServer{server: &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}, refs: 1}
+	metricsServers.servers[addr] = s
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorw("metrics server failed", "address", addr, "error", err)
+		}
+	}()
+	logger.Infow("serving metrics", "address", listener.Addr().String())
+	return nil
+}
+
+func stopMetricsServer(addr string, logger golog.Logger) {
+	metricsServers.Lock()
+	defer metricsServers.Unlock()
+	s, ok := metricsServers.servers[addr]
+	if !ok {
+		return
+	}
+	s.refs--
+	if s.refs > 0 {
+		return
+	}
+	delete(metricsServers.servers, addr)
+	if err := s.server.Close(); err != nil {
+		logger.Debugw("failed to close metrics server", "address", addr, "error", err)
+	}
+}
@@ -0,0 +1,35 @@
+package serial
+
+import (
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// goString copies and frees an XsString returned by value from the SDK.
+func goString(str gen.XSString) string {
+	defer gen.DeleteXSString(str)
+	return str.ToStdString()
+}
+
+// deviceIDString formats a device ID the way it is printed on the device.
+func deviceIDString(id gen.XSDeviceId) string {
+	return goString(id.ToString())
+}
+
+// versionString copies and frees an XsVersion returned by the SDK.
+func versionString(version gen.XsVersion) string {
+	defer gen.DeleteVersion(version)
+	return goString(gen.VersionString(version))
+}
+
+// quaternion copies and frees an XsQuaternion returned by value from the SDK.
+func quaternion(q gen.XSQuaternion) Quaternion {
+	defer gen.DeleteXSQuaternion(q)
+	return Quaternion{W: q.W().(float64), X: q.X().(float64), Y: q.Y().(float64), Z: q.Z().(float64)}
+}
+
+// resultCode copies and frees an XsResultValue returned by value from the
+// SDK.
+func resultCode(result gen.XsResultValue) int {
+	defer gen.DeleteResultValue(result)
+	return gen.ResultCode(result)
+}
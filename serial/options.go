@@ -0,0 +1,87 @@
+package serial
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// DefaultOptionFlags are enabled when a config names no option flags at all.
+var DefaultOptionFlags = []string{"EnableContinuousZRU"}
+
+// deviceOptionFlags maps the XsDeviceOptionFlag names, without their XDOF_
+// prefix, to their values.
+var deviceOptionFlags = map[string]gen.XsDeviceOptionFlag{
+	"DisableAutoStore":               gen.XDOF_DisableAutoStore,
+	"DisableAutoMeasurement":         gen.XDOF_DisableAutoMeasurement,
+	"EnableBeidou":                   gen.XDOF_EnableBeidou,
+	"DisableGps":                     gen.XDOF_DisableGps,
+	"EnableAhs":                      gen.XDOF_EnableAhs,
+	"EnableOrientationSmoother":      gen.XDOF_EnableOrientationSmoother,
+	"EnableConfigurableBusId":        gen.XDOF_EnableConfigurableBusId,
+	"EnableInrunCompassCalibration":  gen.XDOF_EnableInrunCompassCalibration,
+	"DisableSleepMode":               gen.XDOF_DisableSleepMode,
+	"EnableConfigMessageAtStartup":   gen.XDOF_EnableConfigMessageAtStartup,
+	"EnableColdFilterResets":         gen.XDOF_EnableColdFilterResets,
+	"EnablePositionVelocitySmoother": gen.XDOF_EnablePositionVelocitySmoother,
+	"EnableContinuousZRU":            gen.XDOF_EnableContinuousZRU,
+}
+
+// namedOptionFlags returns the flags deviceOptionFlags has a name for.
+func namedOptionFlags() gen.XsDeviceOptionFlag {
+	mask := gen.XDOF_None
+	for _, flag := range deviceOptionFlags {
+		mask |= flag
+	}
+	return mask
+}
+
+// ParseOptionFlag returns the flag with the given name. Names are matched
+// case insensitively and may carry the XDOF_ prefix.
+func ParseOptionFlag(name string) (gen.XsDeviceOptionFlag, error) {
+	trimmed := strings.TrimPrefix(strings.ToLower(name), "xdof_")
+	for flagName, flag := range deviceOptionFlags {
+		if strings.ToLower(flagName) == trimmed {
+			return flag, nil
+		}
+	}
+	return gen.XDOF_None, fmt.Errorf("unknown device option flag %q", name)
+}
+
+// ParseOptionFlags combines the named flags to set and to clear into masks
+// suitable for XSDevice.SetDeviceOptionFlags.
+func ParseOptionFlags(set, clear []string) (gen.XsDeviceOptionFlag, gen.XsDeviceOptionFlag, error) {
+	setMask, clearMask := gen.XDOF_None, gen.XDOF_None
+	for _, name := range set {
+		flag, err := ParseOptionFlag(name)
+		if err != nil {
+			return gen.XDOF_None, gen.XDOF_None, err
+		}
+		setMask |= flag
+	}
+	for _, name := range clear {
+		flag, err := ParseOptionFlag(name)
+		if err != nil {
+			return gen.XDOF_None, gen.XDOF_None, err
+		}
+		if setMask&flag != 0 {
+			return gen.XDOF_None, gen.XDOF_None, fmt.Errorf("device option flag %q is both set and cleared", name)
+		}
+		clearMask |= flag
+	}
+	return setMask, clearMask, nil
+}
+
+// OptionFlagNames returns the sorted names of the flags present in mask.
+func OptionFlagNames(mask gen.XsDeviceOptionFlag) []string {
+	names := []string{}
+	for name, flag := range deviceOptionFlags {
+		if mask&flag != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,119 @@
+package serial
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+func TestParseOptionFlags(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		set, clear []string
+		wantSet    gen.XsDeviceOptionFlag
+		wantClear  gen.XsDeviceOptionFlag
+		wantErr    bool
+	}{
+		{
+			name:      "none",
+			wantSet:   gen.XDOF_None,
+			wantClear: gen.XDOF_None,
+		},
+		{
+			name:      "set and clear",
+			set:       []string{"EnableAhs", "DisableAutoStore"},
+			clear:     []string{"EnableInrunCompassCalibration"},
+			wantSet:   gen.XDOF_EnableAhs | gen.XDOF_DisableAutoStore,
+			wantClear: gen.XDOF_EnableInrunCompassCalibration,
+		},
+		{
+			name:      "case and prefix",
+			set:       []string{"XDOF_ENABLEAHS", "enablecontinuouszru"},
+			wantSet:   gen.XDOF_EnableAhs | gen.XDOF_EnableContinuousZRU,
+			wantClear: gen.XDOF_None,
+		},
+		{
+			name:    "unknown set",
+			set:     []string{"EnableWarpDrive"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown clear",
+			clear:   []string{"EnableWarpDrive"},
+			wantErr: true,
+		},
+		{
+			name:    "set and cleared",
+			set:     []string{"EnableAhs"},
+			clear:   []string{"xdof_EnableAhs"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			set, clear, err := ParseOptionFlags(tc.set, tc.clear)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("ParseOptionFlags succeeded with %#x and %#x, want an error", set, clear)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if set != tc.wantSet || clear != tc.wantClear {
+				t.Errorf("ParseOptionFlags = %#x, %#x, want %#x, %#x", set, clear, tc.wantSet, tc.wantClear)
+			}
+		})
+	}
+}
+
+func TestOptionFlagNames(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		mask gen.XsDeviceOptionFlag
+		want []string
+	}{
+		{name: "none", mask: gen.XDOF_None, want: []string{}},
+		{
+			name: "sorted",
+			mask: gen.XDOF_EnableContinuousZRU | gen.XDOF_DisableAutoStore | gen.XDOF_EnableAhs,
+			want: []string{"DisableAutoStore", "EnableAhs", "EnableContinuousZRU"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := OptionFlagNames(tc.mask); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("OptionFlagNames(%#x) = %v, want %v", tc.mask, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOptionFlagNamesRoundTrip(t *testing.T) {
+	for name, flag := range deviceOptionFlags {
+		parsed, err := ParseOptionFlag(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != flag {
+			t.Errorf("ParseOptionFlag(%q) = %#x, want %#x", name, parsed, flag)
+		}
+		if names := OptionFlagNames(flag); !reflect.DeepEqual(names, []string{name}) {
+			t.Errorf("OptionFlagNames(%#x) = %v, want [%s]", flag, names, name)
+		}
+	}
+}
+
+func TestNamedOptionFlags(t *testing.T) {
+	named := namedOptionFlags()
+	for name, flag := range deviceOptionFlags {
+		if named&flag != flag {
+			t.Errorf("namedOptionFlags() = %#x lacks %s", named, name)
+		}
+	}
+	// a flag the SDK may add later is left out, so applying settings does
+	// not clear it
+	if unnamed := gen.XsDeviceOptionFlag(1 << 30); named&unnamed != 0 {
+		t.Errorf("namedOptionFlags() = %#x has the unnamed flag %#x", named, unnamed)
+	}
+}
@@ -0,0 +1,51 @@
+package serial
+
+import (
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// sampleTimeFineResolution is the period of one SampleTimeFine tick.
+const sampleTimeFineResolution = 100 * time.Microsecond
+
+// vector3 copies a three element vector returned by the SDK and frees it.
+func vector3(v gen.XsVector) r3.Vector {
+	defer gen.DeleteVector(v)
+	if gen.VectorSize(v) < 3 {
+		return r3.Vector{}
+	}
+	return r3.Vector{
+		X: gen.VectorAt(v, 0),
+		Y: gen.VectorAt(v, 1),
+		Z: gen.VectorAt(v, 2),
+	}
+}
+
+// sampleTime converts a packet's SampleTimeFine into a duration since the
+// device started counting.
+func sampleTime(packet gen.XSDataPacket) time.Duration {
+	return time.Duration(packet.SampleTimeFine()) * sampleTimeFineResolution
+}
+
+// sampleTimeUnwrapper extends SampleTimeFine past its wrap around, which
+// comes every 2^32 ticks or about five days.
+type sampleTimeUnwrapper struct {
+	started bool
+	raw     uint32
+	ticks   int64
+}
+
+// unwrap returns raw, the next SampleTimeFine, as a duration since the first
+// one was counted from zero.
+func (u *sampleTimeUnwrapper) unwrap(raw uint32) time.Duration {
+	if !u.started {
+		u.started = true
+		u.ticks = int64(raw)
+	} else {
+		u.ticks += int64(int32(raw - u.raw))
+	}
+	u.raw = raw
+	return time.Duration(u.ticks) * sampleTimeFineResolution
+}
@@ -0,0 +1,279 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// recordingCheckInterval is how often a recording is checked against its
+// size and time limits.
+const recordingCheckInterval = time.Second
+
+// RecordingConfig describes where the live stream is recorded to and when
+// the .mtb files are rotated and removed.
+type RecordingConfig struct {
+	Directory string
+	// MaxFileSize and MaxFileDuration start a new file once the current one
+	// reaches them. Zero means no limit.
+	MaxFileSize     int64
+	MaxFileDuration time.Duration
+	// MaxFiles and MaxAge limit which files of this device are kept in
+	// Directory. Zero means no limit.
+	MaxFiles int
+	MaxAge   time.Duration
+}
+
+// Validate checks that a directory is given and the limits are not negative.
+func (cfg RecordingConfig) Validate() error {
+	if cfg.Directory == "" {
+		return errors.New("recording needs a directory")
+	}
+	if cfg.MaxFileSize < 0 || cfg.MaxFileDuration < 0 || cfg.MaxFiles < 0 || cfg.MaxAge < 0 {
+		return errors.New("recording limits must not be negative")
+	}
+	return nil
+}
+
+// recorder is an active recording to a rotating set of .mtb files.
+type recorder struct {
+	cfg    RecordingConfig
+	prefix string
+	logger golog.Logger
+
+	file        string
+	fileStarted time.Time
+	started     time.Time
+	closedBytes int64
+	files       int
+	// packetsAtStart are the packet stats when recording started, which
+	// the status counts from
+	packetsAtStart PacketStats
+
+	stopCh chan struct{}
+}
+
+// open creates the next log file and starts recording to it.
+func (r *recorder) open(device gen.XSDevice) error {
+	r.fileStarted = time.Now()
+	r.file = filepath.Join(r.cfg.Directory,
+		fmt.Sprintf("%s-%s.mtb", r.prefix, r.fileStarted.UTC().Format("20060102-150405.000")))
+	name := gen.NewXSString(r.file)
+	defer gen.DeleteXSString(name)
+	if code := resultCode(device.CreateLogFile(name)); code != gen.XRV_OK {
+		return newResultError(fmt.Sprintf("create log file %q", r.file), code, goString(device.LastResultText()), nil)
+	}
+	if !device.StartRecording() {
+		err := deviceError(device, nil, "start recording to %q", r.file)
+		device.CloseLogFile()
+		return err
+	}
+	r.files++
+	return nil
+}
+
+// close stops recording and closes the current log file.
+func (r *recorder) close(device gen.XSDevice) error {
+	var err error
+	if !device.StopRecording() {
+		err = deviceError(device, nil, "stop recording to %q", r.file)
+	}
+	if !device.CloseLogFile() && err == nil {
+		err = deviceError(device, nil, "close log file %q", r.file)
+	}
+	r.closedBytes += fileSize(r.file)
+	return err
+}
+
+// full reports whether the current file reached its size or time limit.
+func (r *recorder) full() bool {
+	if r.cfg.MaxFileSize > 0 && fileSize(r.file) >= r.cfg.MaxFileSize {
+		return true
+	}
+	return r.cfg.MaxFileDuration > 0 && time.Since(r.fileStarted) >= r.cfg.MaxFileDuration
+}
+
+// prune removes the oldest files of this device beyond the retention limits.
+// The current file is always kept.
+func (r *recorder) prune() {
+	if r.cfg.MaxFiles == 0 && r.cfg.MaxAge == 0 {
+		return
+	}
+	entries, err := os.ReadDir(r.cfg.Directory)
+	if err != nil {
+		r.logger.Warnw("failed to list recordings", "directory", r.cfg.Directory, "error", err)
+		return
+	}
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, r.prefix+"-") && strings.HasSuffix(name, ".mtb") {
+			files = append(files, filepath.Join(r.cfg.Directory, name))
+		}
+	}
+	// the timestamp in the name sorts oldest first
+	sort.Strings(files)
+	for i, file := range files {
+		if file == r.file {
+			continue
+		}
+		tooMany := r.cfg.MaxFiles > 0 && len(files)-i > r.cfg.MaxFiles
+		tooOld := false
+		if info, err := os.Stat(file); err == nil && r.cfg.MaxAge > 0 {
+			tooOld = time.Since(info.ModTime()) > r.cfg.MaxAge
+		}
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err := os.Remove(file); err != nil {
+			r.logger.Warnw("failed to remove old recording", "file", file, "error", err)
+		}
+	}
+}
+
+// status describes the recording. packets are the current packet stats.
+func (r *recorder) status(packets PacketStats) map[string]interface{} {
+	current := fileSize(r.file)
+	return map[string]interface{}{
+		"recording":     true,
+		"file":          r.file,
+		"file_bytes":    current,
+		"bytes_written": r.closedBytes + current,
+		"files":         r.files,
+		"packet_stats":  packets.since(r.packetsAtStart).toMap(),
+		"started":       r.started.UTC().Format(time.RFC3339),
+	}
+}
+
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// startRecording starts recording the live stream as described by cfg.
+func (c *Compass) startRecording(cfg RecordingConfig) error {
+	if c.recorder != nil {
+		return fmt.Errorf("already recording to %q", c.recorder.file)
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(cfg.Directory, 0o750); err != nil {
+		return err
+	}
+	r := &recorder{
+		cfg:            cfg,
+		prefix:         "xsens-" + c.cfg.DeviceID,
+		logger:         c.logger,
+		started:        time.Now(),
+		packetsAtStart: c.packets.Stats(c.callback),
+		stopCh:         make(chan struct{}),
+	}
+	if err := r.open(c.device); err != nil {
+		return err
+	}
+	r.prune()
+	c.recorder = r
+	c.recordingEnded = ""
+	c.logger.Infow("started recording", "id", c.cfg.DeviceID, "file", r.file)
+
+	go func() {
+		ticker := time.NewTicker(recordingCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stopCh:
+				return
+			case <-ticker.C:
+			}
+			c.mu.Lock()
+			c.rotateRecording(r)
+			c.mu.Unlock()
+		}
+	}()
+	return nil
+}
+
+// rotateRecording moves r on to a new file once the current one is full.
+// It must be called with c.mu held.
+func (c *Compass) rotateRecording(r *recorder) {
+	if c.recorder != r || !r.full() {
+		return
+	}
+	if err := r.close(c.device); err != nil {
+		c.logger.Warnw("failed to close full recording", "error", err)
+	}
+	if err := r.open(c.device); err != nil {
+		c.logger.Errorw("stopped recording", "id", c.cfg.DeviceID, "error", err)
+		close(r.stopCh)
+		c.recorder = nil
+		c.recordingEnded = "failed to start a new file: " + err.Error()
+		return
+	}
+	r.prune()
+	c.logger.Infow("rotated recording", "file", r.file)
+}
+
+// stopRecording stops the active recording and returns its final status.
+func (c *Compass) stopRecording() (map[string]interface{}, error) {
+	r := c.recorder
+	if r == nil {
+		return nil, errors.New("not recording")
+	}
+	close(r.stopCh)
+	c.recorder = nil
+	err := r.close(c.device)
+	status := r.status(c.packets.Stats(c.callback))
+	status["recording"] = false
+	c.logger.Infow("stopped recording", "id", c.cfg.DeviceID, "file", r.file, "bytes_written", status["bytes_written"])
+	return status, err
+}
+
+// recordingStatus implements the "recording_status" command.
+func (c *Compass) recordingStatus() map[string]interface{} {
+	if c.recorder == nil {
+		status := map[string]interface{}{"recording": false}
+		if c.recordingEnded != "" {
+			status["ended"] = c.recordingEnded
+		}
+		return status
+	}
+	return c.recorder.status(c.packets.Stats(c.callback))
+}
+
+// recordingCommand implements the "start_recording" command. Arguments
+// override the configured recording settings: "directory",
+// "max_file_size_mb", "max_file_duration_sec", "max_files" and
+// "max_age_hours".
+func (c *Compass) recordingCommand(cmd map[string]interface{}) (map[string]interface{}, error) {
+	cfg := c.cfg.Recording
+	if v, ok := cmd["directory"].(string); ok {
+		cfg.Directory = v
+	}
+	if v, ok := cmd["max_file_size_mb"].(float64); ok {
+		cfg.MaxFileSize = int64(v * 1e6)
+	}
+	if v, ok := cmd["max_file_duration_sec"].(float64); ok {
+		cfg.MaxFileDuration = time.Duration(v * float64(time.Second))
+	}
+	if v, ok := cmd["max_files"].(float64); ok {
+		cfg.MaxFiles = int(v)
+	}
+	if v, ok := cmd["max_age_hours"].(float64); ok {
+		cfg.MaxAge = time.Duration(v * float64(time.Hour))
+	}
+	if err := c.startRecording(cfg); err != nil {
+		return nil, err
+	}
+	return c.recorder.status(c.packets.Stats(c.callback)), nil
+}
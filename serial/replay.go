@@ -0,0 +1,245 @@
+package serial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+	"go.viam.com/rdk/components/movementsensor"
+)
+
+// ReplayConfig describes a recorded .mtb file to replay instead of a live
+// device.
+type ReplayConfig struct {
+	LogFile string
+	// Speed scales the recorded packet timing: 1 replays in real time, 10
+	// ten times faster. Zero means real time.
+	Speed float64
+	// Step only advances the replay through the "step" command.
+	Step bool
+	// Loop starts over once the end of the file is reached.
+	Loop bool
+	// Logger defaults to golog.Global(). The SDK's own log output is logged
+	// to it from SDKLogLevel up, see SDKLogLevels.
+	Logger      golog.Logger
+	SDKLogLevel string
+}
+
+// Validate checks that a file is given and the speed is not negative.
+func (cfg ReplayConfig) Validate() error {
+	if cfg.LogFile == "" {
+		return errors.New("replay needs a log file")
+	}
+	if cfg.Speed < 0 || math.IsNaN(cfg.Speed) {
+		return fmt.Errorf("invalid replay speed %v", cfg.Speed)
+	}
+	return nil
+}
+
+// replayer feeds the packets of a loaded log file to a Compass.
+type replayer struct {
+	cfg   ReplayConfig
+	count int64
+	index int64 // atomic
+	done  int32 // atomic
+
+	stepCh  chan int
+	stepped chan struct{}
+	exited  chan struct{}
+}
+
+// NewReplay returns a movement sensor which replays a recorded .mtb file
+// through the same packet handling as a live device. Loading the file is
+// given up on when ctx is done.
+func NewReplay(ctx context.Context, cfg ReplayConfig) (movementsensor.MovementSensor, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Speed == 0 {
+		cfg.Speed = 1
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = golog.Global()
+	}
+
+	// c is the owner of the journal sink, so parsing errors are logged too
+	c := &Compass{logger: logger}
+	addJournalSink(c, logger, cfg.SDKLogLevel)
+	var (
+		control gen.XsControl
+		device  gen.XSDevice
+	)
+	load := func() error {
+		control = gen.XsControlConstruct()
+		fileName := gen.NewXSString(cfg.LogFile)
+		defer gen.DeleteXSString(fileName)
+		device = gen.OpenLogFileDevice(control, fileName)
+		if device.Swigcptr() == 0 {
+			return controlError(control, ErrDeviceNotFound, "open an MTi device in log file %q", cfg.LogFile)
+		}
+		if !device.LoadLogFile() {
+			return deviceError(device, nil, "load log file %q", cfg.LogFile)
+		}
+		device.WaitForLoadLogFileDone()
+		return nil
+	}
+	discard := func() {
+		if control != nil {
+			control.Destruct()
+		}
+		removeJournalSink(c)
+	}
+	if err := callContext(ctx, "load log file "+cfg.LogFile, load, func(error) { discard() }); err != nil {
+		var ctxErr *contextError
+		if !errors.As(err, &ctxErr) {
+			discard()
+		}
+		return nil, err
+	}
+
+	r := &replayer{
+		cfg:     cfg,
+		count:   device.GetDataPacketCount(),
+		stepCh:  make(chan int),
+		stepped: make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+	id := device.DeviceId()
+	logger.Infow("loaded log file",
+		"file", cfg.LogFile,
+		"id", deviceIDString(id),
+		"packets", r.count,
+	)
+	gen.DeleteXSDeviceId(id)
+
+	c.control = control
+	c.device = device
+	c.magneticDetector = NewMagneticDisturbanceDetector(MagneticDisturbanceConfig{})
+	c.replay = r
+	c.packets = newPacketTracker(logger)
+	c.clipping = newClipDetector(device)
+	c.connected = true
+	c.storeLink()
+	c.heading.Store(math.NaN())
+	c.magnetic.Store(MagneticDisturbance{Score: math.NaN()})
+	c.closeCh = make(chan struct{})
+	go c.runReplay(r)
+	return c, nil
+}
+
+// runReplay hands the packets to handlePacket, paced by their sample time
+// or by the "step" command.
+func (c *Compass) runReplay(r *replayer) {
+	defer close(r.exited)
+	var (
+		last     time.Duration
+		lastWall time.Time
+		steps    int
+	)
+	for i := int64(0); ; i++ {
+		if i == r.count {
+			if !r.cfg.Loop || r.count == 0 {
+				atomic.StoreInt32(&r.done, 1)
+				c.logger.Infow("finished replaying log file", "file", r.cfg.LogFile)
+				return
+			}
+			i, lastWall = 0, time.Time{}
+		}
+
+		select {
+		case <-c.closeCh:
+			return
+		default:
+		}
+		packet := c.device.GetDataPacketByIndex(i)
+		if r.cfg.Step {
+			if steps == 0 {
+				select {
+				case <-c.closeCh:
+					gen.DeleteXSDataPacket(packet)
+					return
+				case steps = <-r.stepCh:
+				}
+			}
+			steps--
+		} else if packet.ContainsSampleTimeFine() {
+			t := sampleTime(packet)
+			if !lastWall.IsZero() && t > last {
+				wait := time.Duration(float64(t-last)/r.cfg.Speed) - time.Since(lastWall)
+				select {
+				case <-c.closeCh:
+					gen.DeleteXSDataPacket(packet)
+					return
+				case <-time.After(wait):
+				}
+			}
+			last, lastWall = t, time.Now()
+		}
+
+		c.handlePacket(packet)
+		gen.DeleteXSDataPacket(packet)
+		atomic.StoreInt64(&r.index, i+1)
+		if r.cfg.Step && steps == 0 {
+			r.stepped <- struct{}{}
+		}
+	}
+}
+
+func (r *replayer) status() map[string]interface{} {
+	return map[string]interface{}{
+		"log_file": r.cfg.LogFile,
+		"packet":   atomic.LoadInt64(&r.index),
+		"packets":  r.count,
+		"done":     atomic.LoadInt32(&r.done) == 1,
+	}
+}
+
+// step implements the "step" command, which advances a stepwise replay by
+// "count" packets, one by default.
+func (c *Compass) step(cmd map[string]interface{}) (map[string]interface{}, error) {
+	r := c.replay
+	if !r.cfg.Step {
+		return nil, errors.New("replay is not stepwise")
+	}
+	remaining := int(r.count - atomic.LoadInt64(&r.index))
+	if atomic.LoadInt32(&r.done) == 1 || (!r.cfg.Loop && remaining == 0) {
+		return nil, errors.New("replay is done")
+	}
+	count := 1
+	if v, ok := cmd["count"].(float64); ok {
+		count = int(v)
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("invalid step count %d", count)
+	}
+	if !r.cfg.Loop && count > remaining {
+		count = remaining
+	}
+	select {
+	case <-c.closeCh:
+		return nil, errors.New("closed")
+	case r.stepCh <- count:
+	}
+	<-r.stepped
+	return r.status(), nil
+}
+
+// replayCommand runs the commands available while replaying.
+func (c *Compass) replayCommand(name string, cmd map[string]interface{}) (map[string]interface{}, error) {
+	switch name {
+	case "step":
+		return c.step(cmd)
+	case "replay_status":
+		return c.replay.status(), nil
+	case "stats":
+		return c.packets.Stats(nil).toMap(), nil
+	default:
+		return nil, fmt.Errorf("command %q is not available while replaying", name)
+	}
+}
@@ -0,0 +1,128 @@
+package serial
+
+import (
+	"time"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+const (
+	// reconnectTimeout is how long a device may take to come back after a
+	// reset.
+	reconnectTimeout  = 10 * time.Second
+	reconnectInterval = 100 * time.Millisecond
+)
+
+// reboot implements the "reboot" command, which resets the device and
+// reconnects to it.
+func (c *Compass) reboot() (map[string]interface{}, error) {
+	start := time.Now()
+	if err := c.resetDevice(); err != nil {
+		return nil, err
+	}
+	id := c.device.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	return map[string]interface{}{
+		"command":     "reboot",
+		"device_id":   deviceIDString(id),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}, nil
+}
+
+// factoryReset implements the "factory_reset" command, which restores the
+// device's factory defaults, resets it and returns the settings it came back
+// with.
+func (c *Compass) factoryReset() (map[string]interface{}, error) {
+	start := time.Now()
+	if !c.device.GotoConfig() {
+		return nil, deviceError(c.device, nil, "go to config mode")
+	}
+	if !c.device.RestoreFactoryDefaults() {
+		err := deviceError(c.device, ErrConfigRejected, "restore factory defaults")
+		if !c.device.GotoMeasurement() {
+			c.logger.Warnw("failed to go back to measurement mode", "id", c.cfg.DeviceID)
+		}
+		return nil, err
+	}
+	if err := c.resetDevice(); err != nil {
+		return nil, err
+	}
+
+	var settings *DeviceSettings
+	if err := c.withConfigMode(func() error {
+		settings = ReadDeviceSettings(c.device)
+		c.optionFlags = c.device.DeviceOptionFlags()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	settingsMap, err := toMap(settings)
+	if err != nil {
+		return nil, err
+	}
+	id := c.device.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	return map[string]interface{}{
+		"command":     "factory_reset",
+		"device_id":   deviceIDString(id),
+		"duration_ms": time.Since(start).Milliseconds(),
+		"settings":    settingsMap,
+	}, nil
+}
+
+// resetOrientation implements the "reset_heading" and "reset_inclination"
+// commands. With "revert" set the device goes back to its default heading or
+// inclination instead.
+func (c *Compass) resetOrientation(name string, cmd map[string]interface{}) (map[string]interface{}, error) {
+	revert, _ := cmd["revert"].(bool)
+	var method int
+	switch {
+	case name == "reset_heading" && revert:
+		method = gen.XRM_DefaultHeading
+	case name == "reset_heading":
+		method = gen.XRM_Heading
+	case revert:
+		method = gen.XRM_DefaultInclination
+	default:
+		method = gen.XRM_Inclination
+	}
+	if !gen.ResetOrientation(c.device, method) {
+		return nil, deviceError(c.device, nil, "%s", name)
+	}
+	return map[string]interface{}{"command": name, "revert": revert}, nil
+}
+
+// restartFilter implements the "restart_filter" command.
+func (c *Compass) restartFilter() (map[string]interface{}, error) {
+	c.device.RestartFilter()
+	return map[string]interface{}{"command": "restart_filter"}, nil
+}
+
+// resetDevice resets the device, reconnects to it and returns it to
+// measurement mode. The device is reopened through the device manager, and
+// the other users of the device are told about the device it came back as
+// once the command is done, see unlockAfterCommand.
+func (c *Compass) resetDevice() error {
+	devices.beginReset(c.handle)
+	defer devices.endReset(c.handle)
+	if !c.device.Reset() {
+		return deviceError(c.device, nil, "reset device")
+	}
+	device, port, err := devices.reopen(c.handle)
+	if err != nil {
+		return err
+	}
+	if device.Swigcptr() != c.device.Swigcptr() {
+		c.logger.Infow("reconnected to device after reset", deviceFields(device, port)...)
+		gen.AddCallbackHandler(c.callback, device)
+		c.device = device
+		c.cfg.Path = port
+		c.reconnects++
+		c.storeLink()
+		c.resetTo, c.resetToPort = device, port
+	}
+	if !c.device.GotoMeasurement() {
+		return deviceError(c.device, nil, "go to measurement mode after reset")
+	}
+	return nil
+}
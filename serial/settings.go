@@ -0,0 +1,453 @@
+package serial
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+	"gopkg.in/yaml.v3"
+)
+
+// SettingsVersion is the version of the settings file format written by
+// DeviceSettings.Save.
+const SettingsVersion = 1
+
+// DeviceSettings is the persistent configuration of a device.
+type DeviceSettings struct {
+	Version int `json:"version" yaml:"version"`
+
+	// DeviceID, ProductCode and FirmwareVersion describe the device the
+	// settings were read from and are never written.
+	DeviceID        string `json:"device_id,omitempty" yaml:"device_id,omitempty"`
+	ProductCode     string `json:"product_code,omitempty" yaml:"product_code,omitempty"`
+	FirmwareVersion string `json:"firmware_version,omitempty" yaml:"firmware_version,omitempty"`
+
+	BaudRate            int             `json:"baud_rate" yaml:"baud_rate"`
+	OptionFlags         []string        `json:"option_flags" yaml:"option_flags"`
+	FilterProfile       FilterProfile   `json:"filter_profile" yaml:"filter_profile"`
+	OutputConfiguration []OutputSetting `json:"output_configuration" yaml:"output_configuration"`
+	SensorAlignment     Quaternion      `json:"sensor_alignment" yaml:"sensor_alignment"`
+	LocalAlignment      Quaternion      `json:"local_alignment" yaml:"local_alignment"`
+	SyncSettings        []SyncSetting   `json:"sync_settings" yaml:"sync_settings"`
+}
+
+// FilterProfile identifies an onboard filter profile. Only Type is written
+// to a device; Version and Label are informational.
+type FilterProfile struct {
+	Type    int    `json:"type" yaml:"type"`
+	Version int    `json:"version,omitempty" yaml:"version,omitempty"`
+	Label   string `json:"label,omitempty" yaml:"label,omitempty"`
+}
+
+// OutputSetting enables one data identifier at the given frequency in Hz.
+type OutputSetting struct {
+	DataIdentifier DataIdentifier `json:"data_identifier" yaml:"data_identifier"`
+	Frequency      int            `json:"frequency" yaml:"frequency"`
+}
+
+// DataIdentifier is an XsDataIdentifier, written as a hex string such as
+// "0x2030" in settings files.
+type DataIdentifier uint16
+
+// MarshalText implements encoding.TextMarshaler.
+func (id DataIdentifier) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("0x%04X", uint16(id))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *DataIdentifier) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 0, 16)
+	if err != nil {
+		return fmt.Errorf("invalid data identifier %q", text)
+	}
+	*id = DataIdentifier(v)
+	return nil
+}
+
+// Quaternion is a rotation in w, x, y, z order.
+type Quaternion struct {
+	W float64 `json:"w" yaml:"w"`
+	X float64 `json:"x" yaml:"x"`
+	Y float64 `json:"y" yaml:"y"`
+	Z float64 `json:"z" yaml:"z"`
+}
+
+func (q Quaternion) approxEqual(other Quaternion) bool {
+	const epsilon = 1e-6
+	return math.Abs(q.W-other.W) < epsilon && math.Abs(q.X-other.X) < epsilon &&
+		math.Abs(q.Y-other.Y) < epsilon && math.Abs(q.Z-other.Z) < epsilon
+}
+
+// SettingChange describes a setting that differs between two DeviceSettings.
+type SettingChange struct {
+	Setting string      `json:"setting"`
+	Old     interface{} `json:"old"`
+	New     interface{} `json:"new"`
+}
+
+// ReadDeviceSettings reads the current settings from a device.
+func ReadDeviceSettings(device gen.XSDevice) *DeviceSettings {
+	id := device.DeviceId()
+	defer gen.DeleteXSDeviceId(id)
+	settings := &DeviceSettings{
+		Version:         SettingsVersion,
+		DeviceID:        deviceIDString(id),
+		ProductCode:     goString(device.ProductCode()),
+		FirmwareVersion: versionString(device.FirmwareVersion()),
+		BaudRate:        gen.BaudRateToNumeric(device.SerialBaudRate()),
+		OptionFlags:     OptionFlagNames(device.DeviceOptionFlags()),
+		SensorAlignment: quaternion(gen.AlignmentRotation(device, gen.XAF_Sensor)),
+		LocalAlignment:  quaternion(gen.AlignmentRotation(device, gen.XAF_Local)),
+		SyncSettings:    syncSettingsFromArray(device.SyncSettings()),
+	}
+
+	profile := device.OnboardFilterProfile()
+	settings.FilterProfile = FilterProfile{
+		Type:    gen.FilterProfileType(profile),
+		Version: gen.FilterProfileVersion(profile),
+		Label:   goString(gen.FilterProfileLabel(profile)),
+	}
+	gen.DeleteFilterProfile(profile)
+
+	outputs := device.OutputConfiguration()
+	for i := 0; i < gen.OutputConfigurationCount(outputs); i++ {
+		settings.OutputConfiguration = append(settings.OutputConfiguration, OutputSetting{
+			DataIdentifier: DataIdentifier(gen.OutputConfigurationIdentifier(outputs, i)),
+			Frequency:      gen.OutputConfigurationFrequency(outputs, i),
+		})
+	}
+	gen.DeleteXsOutputConfigurationArray(outputs)
+
+	return settings
+}
+
+// DiffDeviceSettings lists the writable settings of want that differ from
+// have. Settings left empty in want are ignored.
+func DiffDeviceSettings(have, want *DeviceSettings) []SettingChange {
+	changes := []SettingChange{}
+	add := func(setting string, old, new interface{}) {
+		changes = append(changes, SettingChange{Setting: setting, Old: old, New: new})
+	}
+
+	if want.BaudRate != 0 && want.BaudRate != have.BaudRate {
+		add("baud_rate", have.BaudRate, want.BaudRate)
+	}
+	if want.OptionFlags != nil {
+		wantFlags, _, err := ParseOptionFlags(want.OptionFlags, nil)
+		haveFlags, _, _ := ParseOptionFlags(have.OptionFlags, nil)
+		if err != nil || wantFlags != haveFlags {
+			add("option_flags", have.OptionFlags, want.OptionFlags)
+		}
+	}
+	if want.FilterProfile.Type != 0 && want.FilterProfile.Type != have.FilterProfile.Type {
+		add("filter_profile", have.FilterProfile, want.FilterProfile)
+	}
+	if want.OutputConfiguration != nil && !outputConfigurationMatches(have.OutputConfiguration, want.OutputConfiguration) {
+		add("output_configuration", have.OutputConfiguration, want.OutputConfiguration)
+	}
+	if want.SensorAlignment != (Quaternion{}) && !want.SensorAlignment.approxEqual(have.SensorAlignment) {
+		add("sensor_alignment", have.SensorAlignment, want.SensorAlignment)
+	}
+	if want.LocalAlignment != (Quaternion{}) && !want.LocalAlignment.approxEqual(have.LocalAlignment) {
+		add("local_alignment", have.LocalAlignment, want.LocalAlignment)
+	}
+	if want.SyncSettings != nil && !reflect.DeepEqual(sortedSyncSettings(want.SyncSettings), sortedSyncSettings(have.SyncSettings)) {
+		add("sync_settings", have.SyncSettings, want.SyncSettings)
+	}
+	return changes
+}
+
+// Data identifiers the device adds to its output configuration by itself.
+const (
+	dataPacketCounter  = DataIdentifier(gen.XDI_PacketCounter)
+	dataSampleTimeFine = DataIdentifier(gen.XDI_SampleTimeFine)
+)
+
+// outputConfigurationMatches reports whether have outputs the data of want
+// at the same frequencies, in any order. The packet counter and sample time
+// the device adds by itself are ignored, as is their frequency.
+func outputConfigurationMatches(have, want []OutputSetting) bool {
+	frequencies := func(outputs []OutputSetting) map[DataIdentifier]int {
+		m := make(map[DataIdentifier]int, len(outputs))
+		for _, output := range outputs {
+			m[output.DataIdentifier] = output.Frequency
+		}
+		return m
+	}
+	haveFrequencies, wantFrequencies := frequencies(have), frequencies(want)
+	for _, id := range []DataIdentifier{dataPacketCounter, dataSampleTimeFine} {
+		if _, ok := wantFrequencies[id]; !ok {
+			delete(haveFrequencies, id)
+		} else if _, ok := haveFrequencies[id]; ok {
+			haveFrequencies[id] = wantFrequencies[id]
+		}
+	}
+	return reflect.DeepEqual(haveFrequencies, wantFrequencies)
+}
+
+// sortedSyncSettings returns a copy of settings with the names spelled as in
+// the SDK, sorted by line and function for comparison.
+func sortedSyncSettings(settings []SyncSetting) []SyncSetting {
+	sorted := make([]SyncSetting, len(settings))
+	for i, setting := range settings {
+		if v, err := enumValue("line", syncLineNames, setting.Line); err == nil {
+			setting.Line = syncLineNames[v]
+		}
+		if v, err := enumValue("function", syncFunctionNames, setting.Function); err == nil {
+			setting.Function = syncFunctionNames[v]
+		}
+		if v, err := enumValue("polarity", syncPolarityNames, setting.Polarity); err == nil {
+			setting.Polarity = syncPolarityNames[v]
+		}
+		sorted[i] = setting
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Line != sorted[j].Line {
+			return sorted[i].Line < sorted[j].Line
+		}
+		return sorted[i].Function < sorted[j].Function
+	})
+	return sorted
+}
+
+// ApplyDeviceSettings writes the settings of want that differ from the
+// device's current settings and returns what was changed. On failure it
+// returns what was written before. The device must be in config mode. A new
+// baud rate only takes effect once the device is reset.
+func ApplyDeviceSettings(device gen.XSDevice, want *DeviceSettings) ([]SettingChange, error) {
+	if want.SyncSettings != nil {
+		if err := ValidateSyncSettings(device, want.SyncSettings); err != nil {
+			return nil, err
+		}
+	}
+	changes := DiffDeviceSettings(ReadDeviceSettings(device), want)
+	// the baud rate is written last so a failure cannot leave the device
+	// unreachable with the remaining settings unwritten
+	var baudRate *SettingChange
+	written := []SettingChange{}
+	for i, change := range changes {
+		var ok bool
+		switch change.Setting {
+		case "baud_rate":
+			baudRate = &changes[i]
+			continue
+		case "option_flags":
+			set, _, err := ParseOptionFlags(want.OptionFlags, nil)
+			if err != nil {
+				return written, err
+			}
+			// flags without a name cannot be listed in the file, so they
+			// are left as they are
+			ok = device.SetDeviceOptionFlags(set, namedOptionFlags()&^set)
+		case "filter_profile":
+			ok = device.SetOnboardFilterProfile(want.FilterProfile.Type)
+		case "output_configuration":
+			outputs := gen.NewOutputConfigurationArray()
+			for _, output := range want.OutputConfiguration {
+				gen.AppendOutputConfiguration(outputs, int(output.DataIdentifier), output.Frequency)
+			}
+			ok = device.SetOutputConfiguration(outputs)
+			gen.DeleteXsOutputConfigurationArray(outputs)
+		case "sensor_alignment":
+			ok = setAlignment(device, gen.XAF_Sensor, want.SensorAlignment)
+		case "local_alignment":
+			ok = setAlignment(device, gen.XAF_Local, want.LocalAlignment)
+		case "sync_settings":
+			array, err := newSyncSettingArray(want.SyncSettings)
+			if err != nil {
+				return written, err
+			}
+			ok = device.SetSyncSettings(array)
+			gen.DeleteSyncSettingArray(array)
+		}
+		if !ok {
+			return written, deviceError(device, ErrConfigRejected, "write %s", change.Setting)
+		}
+		written = append(written, change)
+	}
+	if baudRate != nil {
+		rate := gen.NumericToBaudRate(want.BaudRate)
+		if rate == gen.XBR_Invalid {
+			return written, fmt.Errorf("unsupported baud rate %d: %w", want.BaudRate, ErrWrongBaudRate)
+		}
+		if !device.SetSerialBaudRate(rate) {
+			return written, deviceError(device, ErrConfigRejected, "write baud rate %d", want.BaudRate)
+		}
+		written = append(written, *baudRate)
+	}
+	return written, nil
+}
+
+// checkSettingsDrift compares the device's stored settings to want, logs
+// every setting that drifted and, if write is set, writes the differing
+// settings. The device must be in config mode.
+func checkSettingsDrift(logger golog.Logger, device gen.XSDevice, want *DeviceSettings, write bool) error {
+	if want.SyncSettings != nil {
+		if err := ValidateSyncSettings(device, want.SyncSettings); err != nil {
+			return err
+		}
+	}
+	changes := DiffDeviceSettings(ReadDeviceSettings(device), want)
+	if len(changes) == 0 {
+		logger.Infow("device settings match config")
+		return nil
+	}
+	for _, change := range changes {
+		logger.Warnw("device setting drifted from config",
+			"setting", change.Setting,
+			"device", change.Old,
+			"config", change.New,
+			"write", write,
+		)
+	}
+	if !write {
+		return nil
+	}
+	written, err := ApplyDeviceSettings(device, want)
+	if err != nil {
+		return err
+	}
+	logger.Infow("wrote drifted device settings", "count", len(written))
+	return nil
+}
+
+func setAlignment(device gen.XSDevice, frame int, q Quaternion) bool {
+	xsQ := gen.NewXSQuaternion__SWIG_0(q.W, q.X, q.Y, q.Z)
+	defer gen.DeleteXSQuaternion(xsQ)
+	return gen.SetAlignmentRotation(device, frame, xsQ)
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// Save writes the settings to path as YAML if it ends in .yaml or .yml and as
+// JSON otherwise.
+func (s *DeviceSettings) Save(path string) error {
+	var data []byte
+	var err error
+	if isYAML(path) {
+		data, err = yaml.Marshal(s)
+	} else {
+		data, err = json.MarshalIndent(s, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	//nolint:gosec
+	return os.WriteFile(path, data, 0o644)
+}
+
+// LoadDeviceSettings reads settings written by DeviceSettings.Save.
+func LoadDeviceSettings(path string) (*DeviceSettings, error) {
+	//nolint:gosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var settings DeviceSettings
+	if isYAML(path) {
+		err = yaml.Unmarshal(data, &settings)
+	} else {
+		err = json.Unmarshal(data, &settings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", path, err)
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid settings in %q: %w", path, err)
+	}
+	return &settings, nil
+}
+
+// Validate checks the file version and the names used in the settings.
+func (s *DeviceSettings) Validate() error {
+	if s.Version < 1 || s.Version > SettingsVersion {
+		return fmt.Errorf("unsupported settings version %d", s.Version)
+	}
+	if s.BaudRate != 0 && gen.NumericToBaudRate(s.BaudRate) == gen.XBR_Invalid {
+		return fmt.Errorf("unsupported baud rate %d", s.BaudRate)
+	}
+	if _, _, err := ParseOptionFlags(s.OptionFlags, nil); err != nil {
+		return err
+	}
+	for _, sync := range s.SyncSettings {
+		if err := sync.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, output := range s.OutputConfiguration {
+		if output.Frequency < 0 {
+			return errors.New("output frequencies must not be negative")
+		}
+	}
+	return nil
+}
+
+// exportSettings implements the "export_settings" command, which writes the
+// device's settings to "path".
+func (c *Compass) exportSettings(cmd map[string]interface{}) (map[string]interface{}, error) {
+	path, err := stringArg(cmd, "path")
+	if err != nil {
+		return nil, err
+	}
+	var settings *DeviceSettings
+	if err := c.withConfigMode(func() error {
+		settings = ReadDeviceSettings(c.device)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := settings.Save(path); err != nil {
+		return nil, err
+	}
+	settingsMap, err := toMap(settings)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"path": path, "settings": settingsMap}, nil
+}
+
+// applySettings implements the "apply_settings" command, which writes the
+// settings saved in "path" to the device. With "dry_run" set only the changes
+// that would be made are returned.
+func (c *Compass) applySettings(cmd map[string]interface{}) (map[string]interface{}, error) {
+	path, err := stringArg(cmd, "path")
+	if err != nil {
+		return nil, err
+	}
+	want, err := LoadDeviceSettings(path)
+	if err != nil {
+		return nil, err
+	}
+	dryRun, _ := cmd["dry_run"].(bool)
+
+	var changes []SettingChange
+	err = c.withConfigMode(func() error {
+		if dryRun {
+			changes = DiffDeviceSettings(ReadDeviceSettings(c.device), want)
+			return nil
+		}
+		var err error
+		changes, err = ApplyDeviceSettings(c.device, want)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	changeList, err := toList(changes)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"path": path, "changes": changeList, "dry_run": dryRun}, nil
+}
@@ -0,0 +1,292 @@
+package serial
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// testSettings returns the settings of a device for the tests to change.
+func testSettings() *DeviceSettings {
+	return &DeviceSettings{
+		Version:       SettingsVersion,
+		DeviceID:      "037003F8",
+		BaudRate:      115200,
+		OptionFlags:   []string{"EnableAhs", "EnableContinuousZRU"},
+		FilterProfile: FilterProfile{Type: 13, Version: 1, Label: "general_mag"},
+		OutputConfiguration: []OutputSetting{
+			{DataIdentifier: 0x1020, Frequency: 0xFFFF},
+			{DataIdentifier: 0x2030, Frequency: 100},
+			{DataIdentifier: 0x4020, Frequency: 100},
+		},
+		SensorAlignment: Quaternion{W: 1},
+		LocalAlignment:  Quaternion{W: 1},
+		SyncSettings: []SyncSetting{
+			{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge"},
+			{Line: "Out1", Function: "IntervalTransitionMeasurement", Polarity: "RisingEdge", PulseWidth: 1000},
+		},
+	}
+}
+
+func TestDiffDeviceSettings(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// change makes want out of a copy of the device's settings
+		change func(want *DeviceSettings)
+		want   []string
+	}{
+		{
+			name:   "same",
+			change: func(want *DeviceSettings) {},
+		},
+		{
+			name: "nothing wanted",
+			change: func(want *DeviceSettings) {
+				*want = DeviceSettings{Version: SettingsVersion}
+			},
+		},
+		{
+			name: "informational fields",
+			change: func(want *DeviceSettings) {
+				want.DeviceID = "037003F9"
+				want.FilterProfile.Version = 2
+				want.FilterProfile.Label = "general"
+			},
+		},
+		{
+			name: "option flags in another order and case",
+			change: func(want *DeviceSettings) {
+				want.OptionFlags = []string{"xdof_enablecontinuouszru", "EnableAhs"}
+			},
+		},
+		{
+			name: "alignment within rounding",
+			change: func(want *DeviceSettings) {
+				want.SensorAlignment = Quaternion{W: 1 - 1e-9}
+			},
+		},
+		{
+			name: "output configuration in another order",
+			change: func(want *DeviceSettings) {
+				outputs := want.OutputConfiguration
+				want.OutputConfiguration = []OutputSetting{outputs[2], outputs[0], outputs[1]}
+			},
+		},
+		{
+			name: "output configuration without what the device adds",
+			change: func(want *DeviceSettings) {
+				want.OutputConfiguration = want.OutputConfiguration[1:]
+			},
+		},
+		{
+			name: "sync settings in another order and case",
+			change: func(want *DeviceSettings) {
+				first, second := want.SyncSettings[0], want.SyncSettings[1]
+				second.Line, second.Function = "out1", "intervaltransitionmeasurement"
+				want.SyncSettings = []SyncSetting{second, first}
+			},
+		},
+		{
+			name: "every setting",
+			change: func(want *DeviceSettings) {
+				want.BaudRate = 921600
+				want.OptionFlags = []string{"EnableAhs"}
+				want.FilterProfile.Type = 12
+				want.OutputConfiguration = want.OutputConfiguration[1:2]
+				want.SensorAlignment = Quaternion{W: 0.7071068, Z: 0.7071068}
+				want.LocalAlignment = Quaternion{X: 1}
+				want.SyncSettings = want.SyncSettings[:1]
+			},
+			want: []string{
+				"baud_rate", "option_flags", "filter_profile", "output_configuration",
+				"sensor_alignment", "local_alignment", "sync_settings",
+			},
+		},
+		{
+			name: "no option flags",
+			change: func(want *DeviceSettings) {
+				want.OptionFlags = []string{}
+			},
+			want: []string{"option_flags"},
+		},
+		{
+			name: "unknown option flag",
+			change: func(want *DeviceSettings) {
+				want.OptionFlags = []string{"EnableWarpDrive"}
+			},
+			want: []string{"option_flags"},
+		},
+		{
+			name: "output frequency",
+			change: func(want *DeviceSettings) {
+				want.OutputConfiguration = []OutputSetting{
+					{DataIdentifier: 0x1020, Frequency: 0xFFFF},
+					{DataIdentifier: 0x2030, Frequency: 100},
+					{DataIdentifier: 0x4020, Frequency: 400},
+				}
+			},
+			want: []string{"output_configuration"},
+		},
+		{
+			name: "sync parameter",
+			change: func(want *DeviceSettings) {
+				want.SyncSettings = []SyncSetting{
+					{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge"},
+					{Line: "Out1", Function: "IntervalTransitionMeasurement", Polarity: "RisingEdge", PulseWidth: 2000},
+				}
+			},
+			want: []string{"sync_settings"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := testSettings()
+			tc.change(want)
+			var got []string
+			for _, change := range DiffDeviceSettings(testSettings(), want) {
+				got = append(got, change.Setting)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("changed settings = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOutputConfigurationMatches(t *testing.T) {
+	quaternion := OutputSetting{DataIdentifier: 0x2010, Frequency: 100}
+	acceleration := OutputSetting{DataIdentifier: 0x4020, Frequency: 100}
+	counter := OutputSetting{DataIdentifier: dataPacketCounter, Frequency: 0xFFFF}
+	sampleTime := OutputSetting{DataIdentifier: dataSampleTimeFine, Frequency: 0xFFFF}
+	for _, tc := range []struct {
+		name       string
+		have, want []OutputSetting
+		match      bool
+	}{
+		{name: "empty", match: true},
+		{name: "same", have: []OutputSetting{quaternion, acceleration}, want: []OutputSetting{quaternion, acceleration}, match: true},
+		{name: "order", have: []OutputSetting{acceleration, quaternion}, want: []OutputSetting{quaternion, acceleration}, match: true},
+		{
+			name:  "added by the device",
+			have:  []OutputSetting{counter, sampleTime, quaternion},
+			want:  []OutputSetting{quaternion},
+			match: true,
+		},
+		{
+			name:  "wanted at another frequency",
+			have:  []OutputSetting{counter, quaternion},
+			want:  []OutputSetting{{DataIdentifier: dataPacketCounter, Frequency: 100}, quaternion},
+			match: true,
+		},
+		{
+			name: "wanted but missing",
+			have: []OutputSetting{quaternion},
+			want: []OutputSetting{counter, quaternion},
+		},
+		{name: "missing", have: []OutputSetting{quaternion}, want: []OutputSetting{quaternion, acceleration}},
+		{name: "extra", have: []OutputSetting{quaternion, acceleration}, want: []OutputSetting{quaternion}},
+		{
+			name: "frequency",
+			have: []OutputSetting{quaternion},
+			want: []OutputSetting{{DataIdentifier: 0x2010, Frequency: 400}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := outputConfigurationMatches(tc.have, tc.want); got != tc.match {
+				t.Errorf("outputConfigurationMatches = %v, want %v", got, tc.match)
+			}
+		})
+	}
+}
+
+func TestDeviceSettingsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		change  func(s *DeviceSettings)
+		wantErr bool
+	}{
+		{name: "valid", change: func(s *DeviceSettings) {}},
+		{name: "nothing set", change: func(s *DeviceSettings) { *s = DeviceSettings{Version: 1} }},
+		{name: "no version", change: func(s *DeviceSettings) { s.Version = 0 }, wantErr: true},
+		{name: "newer version", change: func(s *DeviceSettings) { s.Version = SettingsVersion + 1 }, wantErr: true},
+		{name: "baud rate", change: func(s *DeviceSettings) { s.BaudRate = 12345 }, wantErr: true},
+		{name: "option flag", change: func(s *DeviceSettings) { s.OptionFlags = []string{"Nope"} }, wantErr: true},
+		{
+			name:    "sync line",
+			change:  func(s *DeviceSettings) { s.SyncSettings[0].Line = "In9" },
+			wantErr: true,
+		},
+		{
+			name: "negative frequency",
+			change: func(s *DeviceSettings) {
+				s.OutputConfiguration[1].Frequency = -1
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			settings := testSettings()
+			tc.change(settings)
+			if err := settings.Validate(); (err != nil) != tc.wantErr {
+				t.Errorf("Validate() = %v, want an error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeviceSettingsSaveLoad(t *testing.T) {
+	for _, name := range []string{"settings.json", "settings.yaml", "settings.yml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			saved := testSettings()
+			if err := saved.Save(path); err != nil {
+				t.Fatal(err)
+			}
+			loaded, err := LoadDeviceSettings(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(loaded, saved) {
+				t.Errorf("loaded %+v, want %+v", loaded, saved)
+			}
+		})
+	}
+}
+
+func TestDataIdentifierText(t *testing.T) {
+	for _, tc := range []struct {
+		text    string
+		want    DataIdentifier
+		wantErr bool
+	}{
+		{text: "0x2030", want: 0x2030},
+		{text: "0X4020", want: 0x4020},
+		{text: "8240", want: 0x2030},
+		{text: "0x10000", wantErr: true},
+		{text: "quaternion", wantErr: true},
+	} {
+		t.Run(tc.text, func(t *testing.T) {
+			var id DataIdentifier
+			err := id.UnmarshalText([]byte(tc.text))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("UnmarshalText succeeded with %v, want an error", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id != tc.want {
+				t.Errorf("UnmarshalText(%q) = %#x, want %#x", tc.text, uint16(id), uint16(tc.want))
+			}
+			text, err := id.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var again DataIdentifier
+			if err := again.UnmarshalText(text); err != nil || again != id {
+				t.Errorf("%q does not read back as %#x", text, uint16(id))
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package serial
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edaniels/golog"
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+const (
+	// counterWindow is how many recent packet counters are remembered to
+	// tell duplicates from late packets.
+	counterWindow = 1024
+	// lossWarningThreshold is the fraction of lost packets above which a
+	// warning is logged.
+	lossWarningThreshold = 0.01
+	// lossWarningInterval is how often the loss is checked, and so how
+	// often the warning can be logged.
+	lossWarningInterval = time.Minute
+	// rateInterval is how long the packet rate is measured over.
+	rateInterval = time.Second
+)
+
+// PacketStats counts the packets received from the device and the ones lost
+// on the way. Lost is derived from gaps in the PacketCounter, so it covers
+// every cause of loss, including DroppedNative and MissedSDK.
+type PacketStats struct {
+	Received   int64 `json:"received"`
+	Lost       int64 `json:"lost"`
+	Gaps       int64 `json:"gaps"`
+	Duplicates int64 `json:"duplicates"`
+	OutOfOrder int64 `json:"out_of_order"`
+	// Resets counts restarts of the counter, e.g. after a device reset, and
+	// jumps of more than counterWindow either way, which cannot be told
+	// from one.
+	Resets int64 `json:"resets"`
+	// DroppedNative counts packets evicted from the native callback buffer
+	// because Go did not read them in time.
+	DroppedNative int64 `json:"dropped_native"`
+	// MissedSDK counts packets the SDK reported as missed.
+	MissedSDK int64 `json:"missed_sdk"`
+}
+
+func (s PacketStats) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"received":       s.Received,
+		"lost":           s.Lost,
+		"gaps":           s.Gaps,
+		"duplicates":     s.Duplicates,
+		"out_of_order":   s.OutOfOrder,
+		"resets":         s.Resets,
+		"dropped_native": s.DroppedNative,
+		"missed_sdk":     s.MissedSDK,
+	}
+}
+
+// since returns the counts after start, earlier stats of the same packets.
+func (s PacketStats) since(start PacketStats) PacketStats {
+	return PacketStats{
+		Received:      s.Received - start.Received,
+		Lost:          s.Lost - start.Lost,
+		Gaps:          s.Gaps - start.Gaps,
+		Duplicates:    s.Duplicates - start.Duplicates,
+		OutOfOrder:    s.OutOfOrder - start.OutOfOrder,
+		Resets:        s.Resets - start.Resets,
+		DroppedNative: s.DroppedNative - start.DroppedNative,
+		MissedSDK:     s.MissedSDK - start.MissedSDK,
+	}
+}
+
+// packetTracker checks the continuity of the PacketCounter of received
+// packets.
+type packetTracker struct {
+	logger golog.Logger
+
+	mu    sync.Mutex
+	stats PacketStats
+
+	started bool
+	last    uint16
+	// seen holds recent counters, indexed by counter modulo counterWindow,
+	// with -1 for none
+	seen [counterWindow]int32
+
+	checked      time.Time
+	checkedStats PacketStats
+	lastPacket   time.Time
+
+	// rate is the packet rate over the rateInterval ending at rateAt, when
+	// rateReceived packets were received.
+	rate         float64
+	rateAt       time.Time
+	rateReceived int64
+}
+
+func newPacketTracker(logger golog.Logger) *packetTracker {
+	now := time.Now()
+	t := &packetTracker{logger: logger, checked: now, rateAt: now}
+	t.forget()
+	return t
+}
+
+func (t *packetTracker) forget() {
+	for i := range t.seen {
+		t.seen[i] = -1
+	}
+}
+
+// update counts packet and, at most every lossWarningInterval, warns when
+// too many packets were lost since the last check.
+func (t *packetTracker) update(packet gen.XSDataPacket, callback gen.CallbackHandler) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.Received++
+	t.lastPacket = time.Now()
+	if elapsed := t.lastPacket.Sub(t.rateAt); elapsed >= rateInterval {
+		t.rate = float64(t.stats.Received-t.rateReceived) / elapsed.Seconds()
+		t.rateAt, t.rateReceived = t.lastPacket, t.stats.Received
+	}
+	if packet.ContainsPacketCounter() {
+		t.count(packet.PacketCounter())
+	}
+
+	if time.Since(t.checked) < lossWarningInterval {
+		return
+	}
+	stats := t.statsLocked(callback)
+	received := stats.Received - t.checkedStats.Received
+	lost := stats.Lost - t.checkedStats.Lost
+	if lost > 0 && float64(lost) > lossWarningThreshold*float64(received+lost) {
+		t.logger.Warnw("packets lost",
+			"lost", lost,
+			"received", received,
+			"since", t.checked,
+			"dropped_native", stats.DroppedNative-t.checkedStats.DroppedNative,
+			"missed_sdk", stats.MissedSDK-t.checkedStats.MissedSDK,
+		)
+	}
+	t.checked, t.checkedStats = time.Now(), stats
+}
+
+func (t *packetTracker) count(counter uint16) {
+	if !t.started {
+		t.started = true
+		t.last = counter
+		t.seen[int(counter)%counterWindow] = int32(counter)
+		return
+	}
+	slot := int(counter) % counterWindow
+	delta := int16(counter - t.last)
+	switch {
+	case delta == 1:
+	case delta > 1 && delta < counterWindow:
+		t.stats.Gaps++
+		t.stats.Lost += int64(delta) - 1
+	case t.seen[slot] == int32(counter):
+		t.stats.Duplicates++
+		return
+	case delta < 0 && delta > -counterWindow:
+		// a late packet was counted as lost when its successor arrived
+		t.stats.OutOfOrder++
+		if t.stats.Lost > 0 {
+			t.stats.Lost--
+		}
+		t.seen[slot] = int32(counter)
+		return
+	default:
+		t.stats.Resets++
+		t.forget()
+		t.seen[slot] = int32(counter)
+		t.last = counter
+		return
+	}
+	// clear the slots skipped by a gap so they do not look like duplicates
+	// once the counter wraps around to them
+	for c := t.last + 1; c != counter; c++ {
+		t.seen[int(c)%counterWindow] = -1
+	}
+	t.seen[slot] = int32(counter)
+	t.last = counter
+}
+
+// Stats returns the counts so far, including the native buffer's.
+func (t *packetTracker) Stats(callback gen.CallbackHandler) PacketStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.statsLocked(callback)
+}
+
+// LastPacket returns when the latest packet was received, or the zero time.
+func (t *packetTracker) LastPacket() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lastPacket
+}
+
+// Rate returns the packets received per second over the latest
+// rateInterval, or 0 once packets stopped coming.
+func (t *packetTracker) Rate() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if time.Since(t.rateAt) > 2*rateInterval {
+		return 0
+	}
+	return t.rate
+}
+
+func (t *packetTracker) statsLocked(callback gen.CallbackHandler) PacketStats {
+	stats := t.stats
+	if callback != nil {
+		stats.DroppedNative = int64(gen.DroppedPacketCount(callback))
+		stats.MissedSDK = int64(gen.MissedPacketCount(callback))
+	}
+	return stats
+}
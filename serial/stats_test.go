@@ -0,0 +1,118 @@
+package serial
+
+import (
+	"testing"
+
+	"github.com/edaniels/golog"
+)
+
+// counterRun returns the counters from first for n packets, wrapping around
+// like the device's 16 bit PacketCounter.
+func counterRun(first, n int) []uint16 {
+	counters := make([]uint16, n)
+	for i := range counters {
+		counters[i] = uint16(first + i)
+	}
+	return counters
+}
+
+func joinCounters(runs ...[]uint16) []uint16 {
+	var counters []uint16
+	for _, run := range runs {
+		counters = append(counters, run...)
+	}
+	return counters
+}
+
+func TestPacketTrackerCount(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		counters []uint16
+		want     PacketStats
+	}{
+		{
+			name:     "in order",
+			counters: counterRun(0, 100),
+		},
+		{
+			name:     "wraparound",
+			counters: counterRun(65530, 12),
+		},
+		{
+			name:     "several wraparounds",
+			counters: counterRun(0, 200000),
+		},
+		{
+			name:     "gap",
+			counters: []uint16{1, 2, 5, 6},
+			want:     PacketStats{Lost: 2, Gaps: 1},
+		},
+		{
+			name:     "gap across wraparound",
+			counters: []uint16{65534, 65535, 2, 3},
+			want:     PacketStats{Lost: 2, Gaps: 1},
+		},
+		{
+			name:     "duplicate",
+			counters: []uint16{1, 2, 2, 3},
+			want:     PacketStats{Duplicates: 1},
+		},
+		{
+			name:     "late",
+			counters: []uint16{1, 2, 4, 3, 5},
+			want:     PacketStats{Gaps: 1, OutOfOrder: 1},
+		},
+		{
+			name:     "late across wraparound",
+			counters: []uint16{65534, 1, 65535, 0, 2},
+			want:     PacketStats{Gaps: 1, OutOfOrder: 2},
+		},
+		{
+			name:     "late after a long gap",
+			counters: joinCounters(counterRun(100, 1000), []uint16{2000, 1990, 2001}),
+			want:     PacketStats{Lost: 900 - 1, Gaps: 1, OutOfOrder: 1},
+		},
+		{
+			name:     "two gaps",
+			counters: joinCounters(counterRun(0, 1000), counterRun(2000, 10), counterRun(3000, 1000)),
+			want:     PacketStats{Lost: 1000 + 990, Gaps: 2},
+		},
+		{
+			name:     "reset",
+			counters: joinCounters(counterRun(5000, 10), counterRun(0, 10)),
+			want:     PacketStats{Resets: 1},
+		},
+		{
+			name:     "jump beyond the window",
+			counters: joinCounters(counterRun(100, 10), counterRun(30000, 10)),
+			want:     PacketStats{Resets: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := newPacketTracker(golog.NewTestLogger(t))
+			for _, counter := range tc.counters {
+				tracker.count(counter)
+			}
+			got := tracker.stats
+			got.Received = 0
+			if got != tc.want {
+				t.Errorf("stats = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPacketStatsSince(t *testing.T) {
+	tracker := newPacketTracker(golog.NewTestLogger(t))
+	for _, counter := range []uint16{1, 2, 5, 6} {
+		tracker.count(counter)
+	}
+	start := tracker.stats
+	for _, counter := range []uint16{7, 7, 10, 9, 11} {
+		tracker.count(counter)
+	}
+	want := PacketStats{Lost: 1, Gaps: 1, Duplicates: 1, OutOfOrder: 1}
+	if got := tracker.stats.since(start); got != want {
+		t.Errorf("since = %+v, want %+v", got, want)
+	}
+}
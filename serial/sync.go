@@ -0,0 +1,248 @@
+package serial
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/viam-labs/xsens-mti-lib/gen"
+)
+
+// syncLineNames are the XsSyncLine values, without their XSL_ prefix, in
+// enum order.
+var syncLineNames = []string{
+	"In1", "In2", "In3", "Bi1In", "ClockIn", "CtsIn", "GnssClockIn", "ExtTimepulseIn", "ReqData", "Gnss1Pps",
+	"Out1", "Out2", "Bi1Out", "RtsOut",
+}
+
+// syncFunctionNames are the XsSyncFunction values, without their XSF_
+// prefix, in enum order.
+var syncFunctionNames = []string{
+	"StartRecordingIn", "StopRecordingIn", "ResetTimer", "TriggerIndication",
+	"IntervalTransitionMeasurement", "IntervalTransitionRecording", "GotoOperational",
+	"SampleAndSend", "SendLatest", "ClockBiasEstimation", "PulseWithModulation", "StartSampling",
+	"StartRecordingOut", "StopRecordingOut", "Gnss1Pps", "BusSync",
+}
+
+// syncPolarityNames are the XsSyncPolarity values, without their XSP_
+// prefix, in enum order.
+var syncPolarityNames = []string{"None", "RisingEdge", "FallingEdge", "Both"}
+
+// SyncSetting is one entry of a device's synchronization settings. Line,
+// Function and Polarity use the SDK's enum names without their prefix.
+type SyncSetting struct {
+	Line     string `json:"line" yaml:"line"`
+	Function string `json:"function" yaml:"function"`
+	Polarity string `json:"polarity" yaml:"polarity"`
+	// PulseWidth is how long an output pulse lasts in microseconds.
+	PulseWidth int `json:"pulse_width_us,omitempty" yaml:"pulse_width_us,omitempty"`
+	// Offset delays the action after a trigger in microseconds.
+	Offset      int  `json:"offset_us,omitempty" yaml:"offset_us,omitempty"`
+	SkipFirst   int  `json:"skip_first,omitempty" yaml:"skip_first,omitempty"`
+	SkipFactor  int  `json:"skip_factor,omitempty" yaml:"skip_factor,omitempty"`
+	ClockPeriod int  `json:"clock_period_ms,omitempty" yaml:"clock_period_ms,omitempty"`
+	TriggerOnce bool `json:"trigger_once,omitempty" yaml:"trigger_once,omitempty"`
+}
+
+func enumName(names []string, value int) string {
+	if value < 0 || value >= len(names) {
+		return "Invalid"
+	}
+	return names[value]
+}
+
+func enumValue(kind string, names []string, name string) (int, error) {
+	for i, n := range names {
+		if strings.EqualFold(n, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown sync %s %q", kind, name)
+}
+
+// Validate checks that the line, function and polarity names are known.
+func (s SyncSetting) Validate() error {
+	if _, err := enumValue("line", syncLineNames, s.Line); err != nil {
+		return err
+	}
+	if _, err := enumValue("function", syncFunctionNames, s.Function); err != nil {
+		return err
+	}
+	if _, err := enumValue("polarity", syncPolarityNames, s.Polarity); err != nil {
+		return err
+	}
+	return nil
+}
+
+// syncSettingsFromArray copies and frees an XsSyncSettingArray returned by
+// the SDK.
+func syncSettingsFromArray(array gen.XsSyncSettingArray) []SyncSetting {
+	defer gen.DeleteSyncSettingArray(array)
+	settings := make([]SyncSetting, 0, gen.SyncSettingCount(array))
+	for i := 0; i < gen.SyncSettingCount(array); i++ {
+		settings = append(settings, SyncSetting{
+			Line:        enumName(syncLineNames, gen.SyncSettingLine(array, i)),
+			Function:    enumName(syncFunctionNames, gen.SyncSettingFunction(array, i)),
+			Polarity:    enumName(syncPolarityNames, gen.SyncSettingPolarity(array, i)),
+			PulseWidth:  gen.SyncSettingPulseWidth(array, i),
+			Offset:      gen.SyncSettingOffset(array, i),
+			SkipFirst:   gen.SyncSettingSkipFirst(array, i),
+			SkipFactor:  gen.SyncSettingSkipFactor(array, i),
+			ClockPeriod: gen.SyncSettingClockPeriod(array, i),
+			TriggerOnce: gen.SyncSettingTriggerOnce(array, i),
+		})
+	}
+	return settings
+}
+
+// newSyncSettingArray builds an XsSyncSettingArray which the caller must free
+// with gen.DeleteSyncSettingArray.
+func newSyncSettingArray(settings []SyncSetting) (gen.XsSyncSettingArray, error) {
+	array := gen.NewSyncSettingArray()
+	for _, s := range settings {
+		line, err := enumValue("line", syncLineNames, s.Line)
+		if err != nil {
+			gen.DeleteSyncSettingArray(array)
+			return nil, err
+		}
+		function, err := enumValue("function", syncFunctionNames, s.Function)
+		if err != nil {
+			gen.DeleteSyncSettingArray(array)
+			return nil, err
+		}
+		polarity, err := enumValue("polarity", syncPolarityNames, s.Polarity)
+		if err != nil {
+			gen.DeleteSyncSettingArray(array)
+			return nil, err
+		}
+		gen.AppendSyncSetting(array, line, function, polarity,
+			s.PulseWidth, s.Offset, s.SkipFirst, s.SkipFactor, s.ClockPeriod, s.TriggerOnce)
+	}
+	return array, nil
+}
+
+// ValidateSyncSettings checks settings against the synchronization settings
+// the device supports: every setting's line must support its function, only
+// parameters the device takes for that function may be set, and the
+// settings must be compatible with each other.
+func ValidateSyncSettings(device gen.XSDevice, settings []SyncSetting) error {
+	devID := device.DeviceId()
+	defer gen.DeleteXSDeviceId(devID)
+	supported := syncSettingsFromArray(gen.XSDeviceSupportedSyncSettings__SWIG_1(devID))
+	if err := checkSupportedSyncSettings(supported, settings); err != nil {
+		return err
+	}
+
+	array, err := newSyncSettingArray(settings)
+	if err != nil {
+		return err
+	}
+	defer gen.DeleteSyncSettingArray(array)
+	for i := range settings {
+		for j := i + 1; j < len(settings); j++ {
+			if !gen.SyncSettingsCompatible(device, array, i, j) {
+				return fmt.Errorf("sync settings %s on %s and %s on %s are not compatible: %w",
+					settings[i].Function, settings[i].Line, settings[j].Function, settings[j].Line, ErrConfigRejected)
+			}
+		}
+	}
+	return nil
+}
+
+// checkSupportedSyncSettings checks that the line of every setting supports
+// its function and that only the parameters supported marks the function as
+// taking are set.
+func checkSupportedSyncSettings(supported, settings []SyncSetting) error {
+	for _, s := range settings {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		var match *SyncSetting
+		for i, sup := range supported {
+			if strings.EqualFold(sup.Line, s.Line) && strings.EqualFold(sup.Function, s.Function) {
+				match = &supported[i]
+				break
+			}
+		}
+		if match == nil {
+			return fmt.Errorf("device does not support sync function %s on line %s: %w", s.Function, s.Line, ErrConfigRejected)
+		}
+		// the supported settings mark the parameters a function takes
+		// with a non-zero value
+		for _, param := range []struct {
+			name       string
+			set, takes bool
+		}{
+			{"polarity", !strings.EqualFold(s.Polarity, "None"), match.Polarity != "None"},
+			{"pulse_width_us", s.PulseWidth != 0, match.PulseWidth != 0},
+			{"offset_us", s.Offset != 0, match.Offset != 0},
+			{"skip_first", s.SkipFirst != 0, match.SkipFirst != 0},
+			{"skip_factor", s.SkipFactor != 0, match.SkipFactor != 0},
+			{"clock_period_ms", s.ClockPeriod != 0, match.ClockPeriod != 0},
+			{"trigger_once", s.TriggerOnce, match.TriggerOnce},
+		} {
+			if param.set && !param.takes {
+				return fmt.Errorf("sync function %s on line %s does not take %s: %w",
+					s.Function, s.Line, param.name, ErrConfigRejected)
+			}
+		}
+	}
+	return nil
+}
+
+// triggerInputs are the trigger inputs a data packet can indicate.
+const triggerInputs = 3
+
+// TriggerIndication is the last trigger seen on one of the device's trigger
+// inputs. Timestamp is the device's sample time of the trigger.
+type TriggerIndication struct {
+	Input       int    `json:"input"`
+	Line        string `json:"line"`
+	Polarity    string `json:"polarity"`
+	Timestamp   uint32 `json:"timestamp"`
+	FrameNumber int    `json:"frame_number"`
+	// Count is how many triggers were seen on the input.
+	Count int `json:"count"`
+}
+
+// updateTriggerIndications returns a copy of triggers updated with the
+// trigger indications in packet, and whether there were any.
+func updateTriggerIndications(triggers []TriggerIndication, packet gen.XSDataPacket) ([]TriggerIndication, bool) {
+	var updated []TriggerIndication
+	for input := 1; input <= triggerInputs; input++ {
+		if !gen.ContainsTriggerIndication(packet, input) {
+			continue
+		}
+		if updated == nil {
+			updated = make([]TriggerIndication, triggerInputs)
+			copy(updated, triggers)
+		}
+		updated[input-1] = TriggerIndication{
+			Input:       input,
+			Line:        enumName(syncLineNames, gen.TriggerIndicationLine(packet, input)),
+			Polarity:    enumName(syncPolarityNames, gen.TriggerIndicationPolarity(packet, input)),
+			Timestamp:   uint32(gen.TriggerIndicationTimestamp(packet, input)),
+			FrameNumber: gen.TriggerIndicationFrameNumber(packet, input),
+			Count:       updated[input-1].Count + 1,
+		}
+	}
+	return updated, updated != nil
+}
+
+// triggerReadings returns the seen triggers for Readings.
+func triggerReadings(triggers []TriggerIndication) []interface{} {
+	var readings []interface{}
+	for _, t := range triggers {
+		if t.Count == 0 {
+			continue
+		}
+		readings = append(readings, map[string]interface{}{
+			"input":        t.Input,
+			"line":         t.Line,
+			"polarity":     t.Polarity,
+			"timestamp":    t.Timestamp,
+			"frame_number": t.FrameNumber,
+			"count":        t.Count,
+		})
+	}
+	return readings
+}
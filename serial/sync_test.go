@@ -0,0 +1,138 @@
+package serial
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSyncSettingValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		setting SyncSetting
+		wantErr bool
+	}{
+		{name: "valid", setting: SyncSetting{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge"}},
+		{name: "case", setting: SyncSetting{Line: "gnss1pps", Function: "CLOCKBIASESTIMATION", Polarity: "both"}},
+		{name: "line", setting: SyncSetting{Line: "In9", Function: "TriggerIndication", Polarity: "RisingEdge"}, wantErr: true},
+		{name: "function", setting: SyncSetting{Line: "In1", Function: "Trigger", Polarity: "RisingEdge"}, wantErr: true},
+		{name: "polarity", setting: SyncSetting{Line: "In1", Function: "TriggerIndication"}, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.setting.Validate(); (err != nil) != tc.wantErr {
+				t.Errorf("Validate() = %v, want an error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckSupportedSyncSettings(t *testing.T) {
+	// the supported settings mark the parameters a function takes with a
+	// non-zero value
+	supported := []SyncSetting{
+		{Line: "In1", Function: "TriggerIndication", Polarity: "Both"},
+		{Line: "In1", Function: "SendLatest", Polarity: "Both", SkipFirst: 1, SkipFactor: 1},
+		{Line: "Out1", Function: "IntervalTransitionMeasurement", Polarity: "Both", PulseWidth: 1, Offset: 1},
+		{Line: "ClockIn", Function: "ClockBiasEstimation", Polarity: "None", ClockPeriod: 1, TriggerOnce: true},
+	}
+	for _, tc := range []struct {
+		name     string
+		settings []SyncSetting
+		wantErr  bool
+		// rejected is whether the error is ErrConfigRejected, as it is
+		// for names the SDK knows but the device does not support
+		rejected bool
+	}{
+		{name: "none"},
+		{
+			name: "supported",
+			settings: []SyncSetting{
+				{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge"},
+				{Line: "Out1", Function: "IntervalTransitionMeasurement", Polarity: "FallingEdge", PulseWidth: 1000, Offset: 50},
+				{Line: "ClockIn", Function: "ClockBiasEstimation", Polarity: "None", ClockPeriod: 1000, TriggerOnce: true},
+			},
+		},
+		{
+			name:     "case",
+			settings: []SyncSetting{{Line: "in1", Function: "sendlatest", Polarity: "risingedge", SkipFactor: 10}},
+		},
+		{
+			name:     "unknown name",
+			settings: []SyncSetting{{Line: "In9", Function: "TriggerIndication", Polarity: "RisingEdge"}},
+			wantErr:  true,
+		},
+		{
+			name:     "function not on line",
+			settings: []SyncSetting{{Line: "In2", Function: "TriggerIndication", Polarity: "RisingEdge"}},
+			wantErr:  true,
+			rejected: true,
+		},
+		{
+			name:     "parameter not taken",
+			settings: []SyncSetting{{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge", PulseWidth: 1000}},
+			wantErr:  true,
+			rejected: true,
+		},
+		{
+			name:     "polarity not taken",
+			settings: []SyncSetting{{Line: "ClockIn", Function: "ClockBiasEstimation", Polarity: "RisingEdge", ClockPeriod: 1000}},
+			wantErr:  true,
+			rejected: true,
+		},
+		{
+			name:     "trigger once not taken",
+			settings: []SyncSetting{{Line: "In1", Function: "SendLatest", Polarity: "RisingEdge", TriggerOnce: true}},
+			wantErr:  true,
+			rejected: true,
+		},
+		{
+			name: "second setting",
+			settings: []SyncSetting{
+				{Line: "In1", Function: "TriggerIndication", Polarity: "RisingEdge"},
+				{Line: "Out1", Function: "IntervalTransitionMeasurement", Polarity: "RisingEdge", SkipFirst: 2},
+			},
+			wantErr:  true,
+			rejected: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSupportedSyncSettings(supported, tc.settings)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkSupportedSyncSettings = %v, want an error: %v", err, tc.wantErr)
+			}
+			if errors.Is(err, ErrConfigRejected) != tc.rejected {
+				t.Errorf("checkSupportedSyncSettings = %v, want ErrConfigRejected: %v", err, tc.rejected)
+			}
+		})
+	}
+}
+
+func TestTriggerReadings(t *testing.T) {
+	triggers := []TriggerIndication{
+		{Input: 1, Line: "In1", Polarity: "RisingEdge", Timestamp: 1234, FrameNumber: 7, Count: 3},
+		{Input: 2},
+		{Input: 3, Line: "In3", Polarity: "Both", Timestamp: 99, Count: 1},
+	}
+	want := []interface{}{
+		map[string]interface{}{
+			"input": 1, "line": "In1", "polarity": "RisingEdge", "timestamp": uint32(1234), "frame_number": 7, "count": 3,
+		},
+		map[string]interface{}{
+			"input": 3, "line": "In3", "polarity": "Both", "timestamp": uint32(99), "frame_number": 0, "count": 1,
+		},
+	}
+	if got := triggerReadings(triggers); !reflect.DeepEqual(got, want) {
+		t.Errorf("triggerReadings = %v, want %v", got, want)
+	}
+	if got := triggerReadings(make([]TriggerIndication, triggerInputs)); got != nil {
+		t.Errorf("triggerReadings without triggers = %v, want nil", got)
+	}
+}
+
+func TestEnumName(t *testing.T) {
+	for value, want := range map[int]string{0: "None", 3: "Both", -1: "Invalid", 4: "Invalid"} {
+		if got := enumName(syncPolarityNames, value); got != want {
+			t.Errorf("enumName(%d) = %q, want %q", value, got, want)
+		}
+	}
+}
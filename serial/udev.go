@@ -0,0 +1,83 @@
+package serial
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// serialByIDDir holds the stable symlinks udev creates for serial devices.
+const serialByIDDir = "/dev/serial/by-id"
+
+// resolvePort follows symlinks such as /dev/serial/by-id names or udev
+// SYMLINK rules to the port the scanner reports.
+func resolvePort(path string) string {
+	if path == "" {
+		return ""
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return path
+	}
+	return resolved
+}
+
+// serialByID returns the /dev/serial/by-id link pointing to port, if any.
+func serialByID(port string) string {
+	entries, err := os.ReadDir(serialByIDDir)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		link := filepath.Join(serialByIDDir, entry.Name())
+		if resolvePort(link) == port {
+			return link
+		}
+	}
+	return ""
+}
+
+// usbAttributes reads the sysfs attributes of the USB device a tty port
+// belongs to. kernels is the device's position in the USB topology, e.g.
+// "1-1.2".
+func usbAttributes(port string) (kernels string, attrs map[string]string, err error) {
+	dir, err := filepath.EvalSymlinks(filepath.Join("/sys/class/tty", filepath.Base(port), "device"))
+	if err != nil {
+		return "", nil, fmt.Errorf("no sysfs entry for %q: %w", port, err)
+	}
+	for ; dir != "/" && dir != "."; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "idVendor")); err != nil {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, name := range []string{"idVendor", "idProduct", "serial"} {
+			if data, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+				attrs[name] = strings.TrimSpace(string(data))
+			}
+		}
+		return filepath.Base(dir), attrs, nil
+	}
+	return "", nil, fmt.Errorf("%q is not a USB device", port)
+}
+
+// UdevRule returns a udev rule which links the USB device's tty to
+// /dev/<name>. It matches on the USB serial number when the device has one
+// and on its position in the USB topology otherwise.
+func UdevRule(device DiscoveredDevice, name string) (string, error) {
+	if !device.USB {
+		return "", fmt.Errorf("%s on %q is not a USB device", device.DeviceID, device.Port)
+	}
+	kernels, attrs, err := usbAttributes(device.Port)
+	if err != nil {
+		return "", err
+	}
+	match := fmt.Sprintf(`KERNELS=="%s"`, kernels)
+	if serial := attrs["serial"]; serial != "" {
+		match = fmt.Sprintf(`ATTRS{serial}=="%s"`, serial)
+	}
+	return fmt.Sprintf(
+		`# %s %s on %s`+"\n"+`SUBSYSTEM=="tty", ATTRS{idVendor}=="%s", ATTRS{idProduct}=="%s", %s, SYMLINK+="%s"`,
+		device.Family, device.DeviceID, device.Port, attrs["idVendor"], attrs["idProduct"], match, name,
+	), nil
+}
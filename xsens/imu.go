@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/edaniels/golog"
 	"github.com/golang/geo/r3"
@@ -42,16 +43,123 @@ func init() {
 }
 
 type Config struct {
-	SerialPath     string `json:"serial_path"`
+	SerialPath     string `json:"serial_path,omitempty"`
 	SerialBaudRate int    `json:"serial_baud_rate,omitempty"`
 	DeviceID       string `json:"serial_number"`
+
+	// USBBus and USBAddress select the device by its position on the USB
+	// bus instead of by SerialPath.
+	USBBus     int `json:"usb_bus,omitempty"`
+	USBAddress int `json:"usb_address,omitempty"`
+
+	// WaitTimeoutSec is how long to wait at startup for the device to
+	// appear. When zero, startup fails if the device is not present.
+	WaitTimeoutSec float64 `json:"wait_timeout_sec,omitempty"`
+
+	// OptionFlags and DisabledOptionFlags name XsDeviceOptionFlag values
+	// (e.g. "EnableAhs") to set and clear on startup. When both are empty
+	// mtilib.DefaultOptionFlags are set.
+	OptionFlags         []string `json:"option_flags,omitempty"`
+	DisabledOptionFlags []string `json:"disabled_option_flags,omitempty"`
+
+	// The desired device settings, checked against the device's stored
+	// settings on startup. Unset attributes are not checked. Settings that
+	// differ are logged and, with WriteSettings, written to the device.
+	OutputConfiguration []mtilib.OutputSetting `json:"output_configuration,omitempty"`
+	FilterProfile       int                    `json:"filter_profile,omitempty"`
+	SensorAlignment     mtilib.Quaternion      `json:"sensor_alignment,omitempty"`
+	LocalAlignment      mtilib.Quaternion      `json:"local_alignment,omitempty"`
+	// SyncSettings configure the sync in and out lines, e.g. trigger
+	// indications or clock bias estimation, and are checked against the
+	// settings the device supports.
+	SyncSettings  []mtilib.SyncSetting `json:"sync_settings,omitempty"`
+	WriteSettings bool                 `json:"write_settings,omitempty"`
+
+	// MagCalibration is a hard and soft iron correction of the magnetometer
+	// data, as returned by the "finish_mag_calibration" command.
+	MagCalibration *mtilib.MagCalibration `json:"mag_calibration,omitempty"`
+
+	// GyroBiasOnStartupSec, if set, estimates the gyroscope bias for this
+	// many seconds at startup, once the platform is still.
+	GyroBiasOnStartupSec float64 `json:"gyro_bias_on_startup_sec,omitempty"`
+
+	Recording *RecordingConfig `json:"recording,omitempty"`
+
+	// MetricsAddress serves Prometheus metrics on /metrics at this address,
+	// e.g. ":9464".
+	MetricsAddress string `json:"metrics_address,omitempty"`
+
+	// SDKLogLevel is the lowest level of the SDK's own log output that is
+	// logged: debug, info, warn, error or off. Defaults to debug, though the
+	// SDK's journal only has debug entries in a debug build of the SDK.
+	SDKLogLevel string `json:"sdk_log_level,omitempty"`
+	// SDKJournalFile is a file the SDK writes its journal to, for Xsens
+	// support.
+	SDKJournalFile string `json:"sdk_journal_file,omitempty"`
+
+	// LogFile replays a recorded .mtb file instead of connecting to a device.
+	// ReplaySpeed scales its timing, ReplayStep only advances it through the
+	// "step" command and ReplayLoop starts over at the end of the file.
+	LogFile     string  `json:"log_file,omitempty"`
+	ReplaySpeed float64 `json:"replay_speed,omitempty"`
+	ReplayStep  bool    `json:"replay_step,omitempty"`
+	ReplayLoop  bool    `json:"replay_loop,omitempty"`
+}
+
+// RecordingConfig describes how the live stream is recorded to .mtb files.
+type RecordingConfig struct {
+	Directory          string  `json:"directory"`
+	MaxFileSizeMB      float64 `json:"max_file_size_mb,omitempty"`
+	MaxFileDurationSec float64 `json:"max_file_duration_sec,omitempty"`
+	MaxFiles           int     `json:"max_files,omitempty"`
+	MaxAgeHours        float64 `json:"max_age_hours,omitempty"`
+	// OnStartup starts recording as soon as the device is connected.
+	OnStartup bool `json:"on_startup,omitempty"`
+}
+
+func (cfg *RecordingConfig) recordingConfig() mtilib.RecordingConfig {
+	return mtilib.RecordingConfig{
+		Directory:       cfg.Directory,
+		MaxFileSize:     int64(cfg.MaxFileSizeMB * 1e6),
+		MaxFileDuration: time.Duration(cfg.MaxFileDurationSec * float64(time.Second)),
+		MaxFiles:        cfg.MaxFiles,
+		MaxAge:          time.Duration(cfg.MaxAgeHours * float64(time.Hour)),
+	}
+}
+
+func (cfg *Config) replayConfig() mtilib.ReplayConfig {
+	return mtilib.ReplayConfig{
+		LogFile: cfg.LogFile,
+		Speed:   cfg.ReplaySpeed,
+		Step:    cfg.ReplayStep,
+		Loop:    cfg.ReplayLoop,
+	}
+}
+
+// deviceSettings returns the desired device settings declared by the config.
+func (cfg *Config) deviceSettings() *mtilib.DeviceSettings {
+	return &mtilib.DeviceSettings{
+		Version:             mtilib.SettingsVersion,
+		BaudRate:            cfg.SerialBaudRate,
+		FilterProfile:       mtilib.FilterProfile{Type: cfg.FilterProfile},
+		OutputConfiguration: cfg.OutputConfiguration,
+		SensorAlignment:     cfg.SensorAlignment,
+		LocalAlignment:      cfg.LocalAlignment,
+		SyncSettings:        cfg.SyncSettings,
+	}
 }
 
 // Validate ensures all parts of the config are valid.
 func (cfg *Config) Validate(path string) ([]string, error) {
 	var deps []string
-	if cfg.SerialPath == "" {
-		return nil, utils.NewConfigValidationFieldRequiredError(path, "serial_path")
+	if err := mtilib.ValidateSDKLogLevel(cfg.SDKLogLevel); err != nil {
+		return nil, utils.NewConfigValidationError(path, err)
+	}
+	if cfg.LogFile != "" {
+		if err := cfg.replayConfig().Validate(); err != nil {
+			return nil, utils.NewConfigValidationError(path, err)
+		}
+		return deps, nil
 	}
 	// Validating baud rate
 	if !rutils.ValidateBaudRate(baudRateList, int(cfg.SerialBaudRate)) {
@@ -61,6 +169,30 @@ func (cfg *Config) Validate(path string) ([]string, error) {
 	if cfg.DeviceID == "" {
 		return nil, utils.NewConfigValidationFieldRequiredError(path, "serial_number")
 	}
+	if cfg.WaitTimeoutSec < 0 {
+		return nil, utils.NewConfigValidationError(path, errors.Errorf("invalid wait_timeout_sec %v", cfg.WaitTimeoutSec))
+	}
+
+	if cfg.GyroBiasOnStartupSec < 0 || cfg.GyroBiasOnStartupSec > 600 {
+		return nil, utils.NewConfigValidationError(path,
+			errors.Errorf("invalid gyro_bias_on_startup_sec %v, expected at most 600", cfg.GyroBiasOnStartupSec))
+	}
+	if cfg.MagCalibration != nil {
+		if err := cfg.MagCalibration.Validate(); err != nil {
+			return nil, utils.NewConfigValidationError(path, err)
+		}
+	}
+	if _, _, err := mtilib.ParseOptionFlags(cfg.OptionFlags, cfg.DisabledOptionFlags); err != nil {
+		return nil, utils.NewConfigValidationError(path, err)
+	}
+	if err := cfg.deviceSettings().Validate(); err != nil {
+		return nil, utils.NewConfigValidationError(path, err)
+	}
+	if cfg.Recording != nil {
+		if err := cfg.Recording.recordingConfig().Validate(); err != nil {
+			return nil, utils.NewConfigValidationError(path, err)
+		}
+	}
 	return deps, nil
 }
 
@@ -154,5 +286,42 @@ func newXsens(
 	newConf *Config,
 	logger golog.Logger,
 ) (movementsensor.MovementSensor, error) {
-	return mtilib.NewCompass(newConf.DeviceID, newConf.SerialPath, newConf.SerialBaudRate)
+	if newConf.LogFile != "" {
+		replayConfig := newConf.replayConfig()
+		replayConfig.Logger = logger
+		replayConfig.SDKLogLevel = newConf.SDKLogLevel
+		return mtilib.NewReplay(ctx, replayConfig)
+	}
+	optionFlags := newConf.OptionFlags
+	if len(optionFlags) == 0 && len(newConf.DisabledOptionFlags) == 0 {
+		optionFlags = mtilib.DefaultOptionFlags
+	}
+	setFlags, clearFlags, err := mtilib.ParseOptionFlags(optionFlags, newConf.DisabledOptionFlags)
+	if err != nil {
+		return nil, err
+	}
+	compassConfig := mtilib.Config{
+		DeviceID:          newConf.DeviceID,
+		Path:              newConf.SerialPath,
+		USBBus:            newConf.USBBus,
+		USBAddress:        newConf.USBAddress,
+		BaudRate:          newConf.SerialBaudRate,
+		WaitTimeout:       time.Duration(newConf.WaitTimeoutSec * float64(time.Second)),
+		SetOptionFlags:    setFlags,
+		ClearOptionFlags:  clearFlags,
+		Settings:          newConf.deviceSettings(),
+		WriteSettings:     newConf.WriteSettings,
+		Name:              name.Name,
+		MetricsAddress:    newConf.MetricsAddress,
+		Logger:            logger,
+		SDKLogLevel:       newConf.SDKLogLevel,
+		SDKJournalFile:    newConf.SDKJournalFile,
+		MagCalibration:    newConf.MagCalibration,
+		GyroBiasOnStartup: time.Duration(newConf.GyroBiasOnStartupSec * float64(time.Second)),
+	}
+	if newConf.Recording != nil {
+		compassConfig.Recording = newConf.Recording.recordingConfig()
+		compassConfig.RecordOnStartup = newConf.Recording.OnStartup
+	}
+	return mtilib.NewCompass(ctx, compassConfig)
 }